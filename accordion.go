@@ -0,0 +1,200 @@
+package runetui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// AccordionPanel is one collapsible section of an Accordion.
+type AccordionPanel struct {
+	Title   string
+	Content Component
+	Open    bool
+}
+
+// AccordionProps defines properties for the Accordion component.
+// OpenIcon and ClosedIcon default to '▾' and '▸'. FocusedIndex is the panel
+// that HandleAccordionKey toggles.
+type AccordionProps struct {
+	OpenIcon     rune
+	ClosedIcon   rune
+	TitleStyle   TextProps
+	FocusedIndex int
+	Key          string
+}
+
+func (AccordionProps) isProps() {}
+
+// AccordionToggleMsg is dispatched when the user toggles the panel at Index.
+// The application owns the Open state and should flip it in its UpdateFunc.
+type AccordionToggleMsg struct {
+	Index int
+}
+
+// accordion is the private implementation of the Accordion component.
+type accordion struct {
+	props  AccordionProps
+	panels []AccordionPanel
+	body   Component
+}
+
+// Accordion creates a list of collapsible panels. Each title is prefixed with
+// the open or closed icon, and a panel's content is shown only when it is Open.
+//
+// Example:
+//
+//	Accordion(AccordionProps{FocusedIndex: focused},
+//	    AccordionPanel{Title: "General", Content: generalForm, Open: open[0]},
+//	    AccordionPanel{Title: "Advanced", Content: advancedForm, Open: open[1]},
+//	)
+func Accordion(props AccordionProps, panels ...AccordionPanel) Component {
+	if props.OpenIcon == 0 {
+		props.OpenIcon = '▾'
+	}
+	if props.ClosedIcon == 0 {
+		props.ClosedIcon = '▸'
+	}
+
+	var rows []Component
+	for _, panel := range panels {
+		icon := props.ClosedIcon
+		if panel.Open {
+			icon = props.OpenIcon
+		}
+		rows = append(rows, Text(string(icon)+" "+panel.Title, props.TitleStyle))
+		if panel.Open {
+			rows = append(rows, panel.Content)
+		}
+	}
+
+	return &accordion{
+		props:  props,
+		panels: panels,
+		body:   Box(BoxProps{Direction: Column}, rows...),
+	}
+}
+
+// HandleAccordionKey returns a command dispatching AccordionToggleMsg for the
+// focused panel when msg is an Enter or Space key press, and nil otherwise.
+func HandleAccordionKey(props AccordionProps, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "enter", " ":
+		index := props.FocusedIndex
+		return func() tea.Msg { return AccordionToggleMsg{Index: index} }
+	}
+	return nil
+}
+
+// Render generates the titles and open panel contents, one per line.
+func (a *accordion) Render(layout Layout) string {
+	return a.body.Render(layout)
+}
+
+// Children returns the visible titles and open panel contents.
+func (a *accordion) Children() []Component {
+	return a.body.Children()
+}
+
+// Key returns the unique identifier for this component.
+func (a *accordion) Key() string {
+	return a.props.Key
+}
+
+func (a *accordion) label() string {
+	return "accordion"
+}
+
+// Measure sums one line per title plus the height of each open panel, and
+// takes the widest of them as the width.
+func (a *accordion) Measure(availableWidth, availableHeight int) Size {
+	var size Size
+	for _, child := range a.body.Children() {
+		childSize := child.Measure(availableWidth, availableHeight)
+		size.Width = max(size.Width, childSize.Width)
+		size.Height += childSize.Height
+	}
+	return size
+}
+
+// CollapsibleSectionProps defines properties for the CollapsibleSection
+// component. ArrowExpanded and ArrowCollapsed default to '▼' and '▶'.
+// BorderStyle draws a border around the title and content.
+type CollapsibleSectionProps struct {
+	Title          string
+	Expanded       bool
+	TitleColor     string
+	ArrowExpanded  rune
+	ArrowCollapsed rune
+	BorderStyle    BorderStyle
+	Key            string
+}
+
+func (CollapsibleSectionProps) isProps() {}
+
+// collapsibleSection is the private implementation of the CollapsibleSection
+// component.
+type collapsibleSection struct {
+	props CollapsibleSectionProps
+	body  Component
+}
+
+// CollapsibleSection creates a single panel with a title line and content that
+// is shown only when Expanded is true, such as a stack trace or config details.
+// The application owns the Expanded state and flips it in its UpdateFunc, for
+// example on Enter or Space. Use Accordion for a list of panels.
+//
+// Example:
+//
+//	CollapsibleSection(CollapsibleSectionProps{Title: "Stack trace", Expanded: showTrace},
+//	    Text(trace),
+//	)
+func CollapsibleSection(props CollapsibleSectionProps, content Component) Component {
+	if props.ArrowExpanded == 0 {
+		props.ArrowExpanded = '▼'
+	}
+	if props.ArrowCollapsed == 0 {
+		props.ArrowCollapsed = '▶'
+	}
+
+	arrow := props.ArrowCollapsed
+	if props.Expanded {
+		arrow = props.ArrowExpanded
+	}
+	rows := []Component{Text(string(arrow)+" "+props.Title, TextProps{Color: props.TitleColor})}
+	if props.Expanded && content != nil {
+		rows = append(rows, content)
+	}
+
+	return &collapsibleSection{
+		props: props,
+		body:  Box(BoxProps{Direction: Column, Border: props.BorderStyle}, rows...),
+	}
+}
+
+// Render generates the title line and, when expanded, the content below it.
+func (c *collapsibleSection) Render(layout Layout) string {
+	return c.body.Render(layout)
+}
+
+// Children returns the title and, when expanded, the content.
+func (c *collapsibleSection) Children() []Component {
+	return c.body.Children()
+}
+
+// Key returns the unique identifier for this component.
+func (c *collapsibleSection) Key() string {
+	return c.props.Key
+}
+
+func (c *collapsibleSection) label() string {
+	return "collapsible"
+}
+
+// Measure returns one line for the title, plus the content's height when
+// expanded, plus the border.
+func (c *collapsibleSection) Measure(availableWidth, availableHeight int) Size {
+	return c.body.Measure(availableWidth, availableHeight)
+}
@@ -0,0 +1,173 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func samplePanels(firstOpen, secondOpen bool) []AccordionPanel {
+	return []AccordionPanel{
+		{Title: "General", Content: VStack(Text("name"), Text("email")), Open: firstOpen},
+		{Title: "Advanced", Content: Text("debug"), Open: secondOpen},
+	}
+}
+
+func TestAccordion_Render_ShowsOnlyOpenContent(t *testing.T) {
+	a := Accordion(AccordionProps{}, samplePanels(true, false)...)
+
+	output := StripANSI(a.Render(Layout{Width: 10, Height: 4}))
+
+	AssertContainsText(t, output, "▾ General")
+	AssertContainsText(t, output, "name")
+	AssertContainsText(t, output, "▸ Advanced")
+	if strings.Contains(output, "debug") {
+		t.Errorf("expected closed content to be hidden, got %q", output)
+	}
+}
+
+func TestAccordion_CustomIcons_PrefixTitles(t *testing.T) {
+	a := Accordion(AccordionProps{OpenIcon: '-', ClosedIcon: '+'}, samplePanels(false, true)...)
+
+	output := StripANSI(a.Render(Layout{Width: 10, Height: 3}))
+
+	AssertContainsText(t, output, "+ General")
+	AssertContainsText(t, output, "- Advanced")
+	AssertContainsText(t, output, "debug")
+}
+
+func TestAccordion_Measure_SumsTitlesAndOpenContent(t *testing.T) {
+	tests := []struct {
+		name   string
+		panels []AccordionPanel
+		height int
+	}{
+		{"all closed", samplePanels(false, false), 2},
+		{"first open", samplePanels(true, false), 4},
+		{"all open", samplePanels(true, true), 5},
+	}
+	titleWidth := Text("▸ Advanced").Measure(80, 24).Width
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := Accordion(AccordionProps{}, tt.panels...).Measure(80, 24)
+			if size.Height != tt.height {
+				t.Errorf("expected height %d, got %d", tt.height, size.Height)
+			}
+			if size.Width != titleWidth {
+				t.Errorf("expected width of the longest title, got %d", size.Width)
+			}
+		})
+	}
+}
+
+func TestAccordion_KeyAndChildren(t *testing.T) {
+	a := Accordion(AccordionProps{Key: "settings"}, samplePanels(true, false)...)
+
+	if a.Key() != "settings" {
+		t.Errorf("expected key 'settings', got %q", a.Key())
+	}
+	if len(a.Children()) != 3 {
+		t.Errorf("expected 2 titles and 1 open content, got %d children", len(a.Children()))
+	}
+}
+
+func TestHandleAccordionKey_EnterAndSpace_ToggleFocusedPanel(t *testing.T) {
+	props := AccordionProps{FocusedIndex: 1}
+
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyEnter}, {Type: tea.KeySpace, Runes: []rune{' '}}} {
+		cmd := HandleAccordionKey(props, key)
+		if cmd == nil {
+			t.Fatalf("expected command for %q", key.String())
+		}
+		if msg, ok := cmd().(AccordionToggleMsg); !ok || msg.Index != 1 {
+			t.Errorf("expected AccordionToggleMsg{Index: 1}, got %#v", cmd())
+		}
+	}
+}
+
+func TestHandleAccordionKey_OtherMessages_ReturnNil(t *testing.T) {
+	props := AccordionProps{}
+
+	if cmd := HandleAccordionKey(props, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}); cmd != nil {
+		t.Error("expected nil for other keys")
+	}
+	if cmd := HandleAccordionKey(props, tea.WindowSizeMsg{}); cmd != nil {
+		t.Error("expected nil for non-key messages")
+	}
+}
+
+func TestCollapsibleSection_Collapsed_ShowsOnlyTitle(t *testing.T) {
+	section := CollapsibleSection(CollapsibleSectionProps{Title: "Details"}, Text("secret"))
+
+	output := StripANSI(section.Render(Layout{Width: 12, Height: 1}))
+
+	AssertContainsText(t, output, "▶ Details")
+	if strings.Contains(output, "secret") {
+		t.Errorf("expected collapsed content to be hidden, got %q", output)
+	}
+}
+
+func TestCollapsibleSection_Expanded_ShowsTitleAndContent(t *testing.T) {
+	section := CollapsibleSection(CollapsibleSectionProps{Title: "Details", Expanded: true}, VStack(Text("line 1"), Text("line 2")))
+
+	lines := strings.Split(StripANSI(section.Render(Layout{Width: 12, Height: 3})), "\n")
+
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "▼ Details") || !strings.HasPrefix(lines[2], "line 2") {
+		t.Errorf("expected title then content, got %q", lines)
+	}
+}
+
+func TestCollapsibleSection_CustomArrowsAndBorder(t *testing.T) {
+	section := CollapsibleSection(CollapsibleSectionProps{
+		Title:          "Config",
+		ArrowCollapsed: '+',
+		BorderStyle:    BorderRounded,
+	}, Text("value"))
+
+	output := StripANSI(section.Render(Layout{Width: 10, Height: 3}))
+
+	AssertContainsText(t, output, "│+ Config│")
+	AssertContainsText(t, output, "╭")
+}
+
+func TestCollapsibleSection_TitleColor_StylesTitle(t *testing.T) {
+	section := CollapsibleSection(CollapsibleSectionProps{Title: "Details", TitleColor: "#FF0000"}, Text("x"))
+
+	output := section.Render(Layout{Width: 12, Height: 1})
+
+	if !strings.Contains(output, "\x1b[") {
+		t.Errorf("expected a styled title, got %q", output)
+	}
+}
+
+func TestCollapsibleSection_Measure_DependsOnExpanded(t *testing.T) {
+	content := VStack(Text("line 1"), Text("line 2"), Text("line 3"))
+	tests := []struct {
+		name     string
+		props    CollapsibleSectionProps
+		expected Size
+	}{
+		{"collapsed", CollapsibleSectionProps{Title: "Details"}, Size{Width: 9, Height: 1}},
+		{"expanded", CollapsibleSectionProps{Title: "Details", Expanded: true}, Size{Width: 9, Height: 4}},
+		{"collapsed with border", CollapsibleSectionProps{Title: "Details", BorderStyle: BorderSingle}, Size{Width: 11, Height: 3}},
+		{"expanded with border", CollapsibleSectionProps{Title: "Details", Expanded: true, BorderStyle: BorderSingle}, Size{Width: 11, Height: 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := CollapsibleSection(tt.props, content).Measure(80, 24)
+			if size != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, size)
+			}
+		})
+	}
+}
+
+func TestCollapsibleSection_Key(t *testing.T) {
+	section := CollapsibleSection(CollapsibleSectionProps{Title: "Details", Key: "details"}, Text("x"))
+
+	if section.Key() != "details" {
+		t.Errorf("expected key %q, got %q", "details", section.Key())
+	}
+}
@@ -2,6 +2,13 @@ package runetui
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -16,11 +23,38 @@ type InitFunc func() tea.Cmd
 
 // App represents a RuneTUI application.
 type App struct {
-	rootFunc      ComponentFunc
-	layoutEngine  *LayoutEngine
-	staticManager *StaticManager
-	updateFunc    UpdateFunc
-	initFunc      InitFunc
+	rootFunc         ComponentFunc
+	layoutEngine     *LayoutEngine
+	staticManager    *StaticManager
+	updateFunc       UpdateFunc
+	initFunc         InitFunc
+	clearOnExit      bool
+	slogHandler      slog.Handler
+	recovery         func(err interface{}) Component
+	loggedPanics     map[string]bool
+	validation       bool
+	loggedValidation map[string]bool
+	debugLayout      bool
+	fullscreen       bool
+	altScreen        bool
+	mouseSupport     bool
+	theme            *Theme
+	noColor          bool
+	shortcuts        []Shortcut
+	initialModel     interface{}
+	ctx              context.Context
+	teaOptions       []tea.ProgramOption
+	input            io.Reader
+	output           io.Writer
+	onReady          func()
+	readyOnce        sync.Once
+
+	mu       sync.Mutex
+	queue    *messageQueue
+	lastTree *LayoutTree
+	// lastTreeY is the terminal row where lastTree was drawn, below the
+	// static zone.
+	lastTreeY int
 }
 
 // AppOption is a function that configures an App.
@@ -40,12 +74,129 @@ func WithInit(fn InitFunc) AppOption {
 	}
 }
 
+// WithClearOnExit controls whether the final frame is cleared when the app exits.
+// When clear is false and the app ran with WithAltScreen, the last rendered
+// frame is written to the normal screen after the program stops so it remains
+// in the scrollback for the user to copy. The default is true, which preserves
+// Bubble Tea's behavior.
+//
+// Inline apps are unaffected: the inline renderer already leaves its last
+// frame in place, so writing it again would show it twice.
+func WithClearOnExit(clear bool) AppOption {
+	return func(a *App) {
+		a.clearOnExit = clear
+	}
+}
+
+// WithFullscreen controls whether the root component is stretched to fill the
+// terminal. When enabled, the root is wrapped in a Box sized to 100% of the
+// current terminal width and height. The default is false, which sizes the
+// root to its content.
+func WithFullscreen(fullscreen bool) AppOption {
+	return func(a *App) {
+		a.fullscreen = fullscreen
+	}
+}
+
+// WithAltScreen runs the app in the terminal's alternate screen buffer, taking
+// over the whole terminal like htop or vim and restoring the previous contents
+// on exit. Full-screen UIs usually pair it with WithMouseSupport and
+// WithFullscreen(true).
+func WithAltScreen() AppOption {
+	return func(a *App) {
+		a.altScreen = true
+	}
+}
+
+// WithNoAltScreen renders the app inline in the normal screen buffer. This is
+// the default; use it to override an earlier WithAltScreen.
+func WithNoAltScreen() AppOption {
+	return func(a *App) {
+		a.altScreen = false
+	}
+}
+
+// WithMouseSupport enables mouse click, release, wheel and drag events. They
+// reach the UpdateFunc as tea.MouseMsg values; use MouseHandler to route them
+// to the component under the pointer.
+func WithMouseSupport() AppOption {
+	return func(a *App) {
+		a.mouseSupport = true
+	}
+}
+
+// WithInitialModel stores typed application state on the App at creation
+// time. Retrieve it with State. Passing a pointer lets update functions mutate
+// the state in place without capturing it in closures.
+func WithInitialModel[S any](state S) AppOption {
+	return func(a *App) {
+		a.initialModel = state
+	}
+}
+
+// State returns the state stored with WithInitialModel. It returns the zero
+// value of S when no state was set or the stored state is not of type S.
+// Go methods cannot have type parameters, so State is a function rather than
+// a method on App.
+//
+// Example:
+//
+//	app := runetui.New(rootFunc, runetui.WithInitialModel(&Model{}))
+//	model := runetui.State[*Model](app)
+func State[S any](a *App) S {
+	state, _ := a.initialModel.(S)
+	return state
+}
+
+// WithContext makes Run quit the program and return an error wrapping
+// ctx.Err() when ctx is cancelled, for callers that cannot switch to
+// RunContext.
+func WithContext(ctx context.Context) AppOption {
+	return func(a *App) {
+		a.ctx = ctx
+	}
+}
+
+// WithProgramOptions passes opts to the Bubble Tea program on Run, before
+// the options derived from other AppOptions, which take precedence.
+//
+// Example:
+//
+//	app := runetui.New(rootFunc, runetui.WithProgramOptions(tea.WithFPS(30)))
+func WithProgramOptions(opts ...tea.ProgramOption) AppOption {
+	return func(a *App) {
+		a.teaOptions = append(a.teaOptions, opts...)
+	}
+}
+
+// WithIO makes the program read input from in and write frames to out instead
+// of the process's stdin and stdout. Use it to serve the app over a network
+// connection, such as an SSH session. Either may be nil to keep the default.
+func WithIO(in io.Reader, out io.Writer) AppOption {
+	return func(a *App) {
+		a.input = in
+		a.output = out
+	}
+}
+
+// WithSize sets the terminal size used for layout until the program reports
+// a resize. Bubble Tea detects the size itself when writing to a terminal;
+// set it when the output is not one, for example an SSH session whose size
+// comes from its PTY request.
+func WithSize(width, height int) AppOption {
+	return func(a *App) {
+		a.layoutEngine.SetDimensions(width, height)
+	}
+}
+
 // New creates a new RuneTUI application with the given root component function.
 func New(rootFunc ComponentFunc, opts ...AppOption) *App {
 	app := &App{
 		rootFunc:      rootFunc,
 		layoutEngine:  NewLayoutEngine(80, 24),
 		staticManager: NewStaticManager(),
+		clearOnExit:   true,
+		noColor:       noColorFromEnv(),
 	}
 
 	for _, opt := range opts {
@@ -55,11 +206,242 @@ func New(rootFunc ComponentFunc, opts ...AppOption) *App {
 	return app
 }
 
+// Session returns a new App with the same options as a, applying opts on top,
+// and its own layout engine, static zone and message delivery. Use it to run
+// several programs from one configuration at the same time, such as one per
+// SSH connection. Sessions render one at a time, see renderMu. State
+// captured by the root, update and init functions is still shared between
+// sessions.
+func (a *App) Session(opts ...AppOption) *App {
+	session := &App{
+		rootFunc:      a.RootFunc(),
+		layoutEngine:  NewLayoutEngine(a.layoutEngine.TerminalWidth(), a.layoutEngine.TerminalHeight()),
+		staticManager: NewStaticManager(),
+		updateFunc:    a.updateFunc,
+		initFunc:      a.initFunc,
+		clearOnExit:   a.clearOnExit,
+		slogHandler:   a.slogHandler,
+		recovery:      a.recovery,
+		validation:    a.validation,
+		debugLayout:   a.debugLayout,
+		fullscreen:    a.fullscreen,
+		altScreen:     a.altScreen,
+		mouseSupport:  a.mouseSupport,
+		theme:         a.theme,
+		noColor:       a.noColor,
+		shortcuts:     a.shortcuts,
+		initialModel:  a.initialModel,
+		ctx:           a.ctx,
+		teaOptions:    a.teaOptions,
+		input:         a.input,
+		output:        a.output,
+		onReady:       a.onReady,
+	}
+	if a.slogHandler != nil {
+		session.slogHandler = newStaticHandler(staticWriter{app: session})
+	}
+	for _, opt := range opts {
+		opt(session)
+	}
+	return session
+}
+
+// RootFunc returns the function that builds the app's component tree.
+func (a *App) RootFunc() ComponentFunc {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.rootFunc
+}
+
+// SetRootFunc replaces the function that builds the app's component tree and
+// triggers a re-render. It is safe to call from any goroutine, which makes it
+// suitable for switching pages in multi-page applications.
+func (a *App) SetRootFunc(fn ComponentFunc) {
+	a.mu.Lock()
+	a.rootFunc = fn
+	a.mu.Unlock()
+	a.send(refreshMsg{})
+}
+
+// StaticManager returns the manager holding the app's static zones. Use it
+// to reset a zone, for example when a process whose log it shows restarts.
+func (a *App) StaticManager() *StaticManager {
+	return a.staticManager
+}
+
+// UpdateFunc returns the update function set with WithUpdate, or nil.
+func (a *App) UpdateFunc() UpdateFunc {
+	return a.updateFunc
+}
+
+// InitFunc returns the init function set with WithInit, or nil.
+func (a *App) InitFunc() InitFunc {
+	return a.initFunc
+}
+
+// OnReady registers a callback that runs once, on its own goroutine, after the
+// first frame has been rendered. Use it to start background work that should
+// not produce output before the UI has taken over the terminal.
+func (a *App) OnReady(callback func()) {
+	a.onReady = callback
+}
+
+// ready runs the OnReady callback the first time it is called.
+func (a *App) ready() {
+	a.readyOnce.Do(func() {
+		if a.onReady != nil {
+			go a.onReady()
+		}
+	})
+}
+
+// refreshMsg asks the running program to re-render without a state change.
+type refreshMsg struct{}
+
+// Printf formats according to a format specifier and appends the result to the
+// static zone, one line per newline-separated segment, then triggers a re-render.
+// It is safe to call from any goroutine, which lets background work stream output
+// without going through the UpdateFunc.
+func (a *App) Printf(format string, args ...any) {
+	output := strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+	a.staticManager.Append(strings.Split(output, "\n")...)
+	a.send(refreshMsg{})
+}
+
+// Refresh re-renders the app without sending a message to the UpdateFunc.
+// Use it when state changed outside the message loop, for example in a value
+// updated by a background goroutine. It is safe to call from any goroutine and
+// does nothing when the app is not running.
+func (a *App) Refresh() {
+	a.send(refreshMsg{})
+}
+
+// SendMessage delivers msg to the running program as if it came from the
+// terminal, so it reaches the UpdateFunc and triggers a re-render. Use it to
+// inject custom messages from scripts or background work. It is safe to call
+// from any goroutine and does nothing when the app is not running.
+func (a *App) SendMessage(msg tea.Msg) {
+	a.send(msg)
+}
+
+// send delivers a message to the running program, if any.
+// Messages are queued and delivered in the order they were sent, without
+// blocking the caller, so it is safe to call from Update or View, which run
+// on the program's event loop.
+func (a *App) send(msg tea.Msg) {
+	a.mu.Lock()
+	q := a.queue
+	a.mu.Unlock()
+	if q != nil {
+		q.push(msg)
+	}
+}
+
+// setProgram records the running program so messages can be sent to it.
+// Passing nil stops delivery to the previous program.
+func (a *App) setProgram(p *tea.Program) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.queue != nil {
+		a.queue.close()
+		a.queue = nil
+	}
+	if p != nil {
+		a.queue = newMessageQueue(p)
+	}
+}
+
+// messageQueue delivers messages to a program on a single goroutine, in the
+// order they were pushed.
+type messageQueue struct {
+	mu      sync.Mutex
+	pending []tea.Msg
+	wake    chan struct{}
+	done    chan struct{}
+}
+
+// newMessageQueue starts delivering pushed messages to p.
+func newMessageQueue(p *tea.Program) *messageQueue {
+	q := &messageQueue{
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	go q.forward(p)
+	return q
+}
+
+// push queues msg without waiting for the program to receive it.
+func (q *messageQueue) push(msg tea.Msg) {
+	q.mu.Lock()
+	q.pending = append(q.pending, msg)
+	q.mu.Unlock()
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// close stops delivery. Messages still queued are dropped.
+func (q *messageQueue) close() {
+	close(q.done)
+}
+
+// forward sends queued messages to p until the queue is closed.
+func (q *messageQueue) forward(p *tea.Program) {
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-q.wake:
+		}
+		q.mu.Lock()
+		msgs := q.pending
+		q.pending = nil
+		q.mu.Unlock()
+		for _, msg := range msgs {
+			p.Send(msg)
+		}
+	}
+}
+
+// LayoutTree returns the layout of the most recently rendered frame, or nil
+// before the first render. Its coordinates start below the static zone; use
+// MouseHandler.Handle to route mouse events, which accounts for that offset.
+func (a *App) LayoutTree() *LayoutTree {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.lastTree
+}
+
+// setLayoutTree records the layout of the frame being rendered and the
+// terminal row it starts at.
+func (a *App) setLayoutTree(tree *LayoutTree, y int) {
+	a.mu.Lock()
+	a.lastTree = tree
+	a.lastTreeY = y
+	a.mu.Unlock()
+}
+
+// layoutTreeAt returns the layout of the most recently rendered frame and
+// the terminal row it starts at.
+func (a *App) layoutTreeAt() (*LayoutTree, int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.lastTree, a.lastTreeY
+}
+
 // model is the internal Bubble Tea model.
 type model struct {
 	app *App
 }
 
+// Model returns a new Bubble Tea model for the app. Use it to drive the app
+// without a terminal, for example to record sessions or to embed the app in
+// another Bubble Tea program. Messages are handled as in Run.
+func (a *App) Model() tea.Model {
+	return a.createModel()
+}
+
 // createModel creates a new Bubble Tea model for this app.
 func (a *App) createModel() tea.Model {
 	return &model{
@@ -76,72 +458,211 @@ func (m *model) Init() tea.Cmd {
 }
 
 // Update handles incoming messages.
+// Resize messages update the layout engine before being forwarded to the
+// UpdateFunc, so user code reacting to a resize sees the new dimensions.
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(refreshMsg); ok {
+		return m, nil
+	}
+
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.app.layoutEngine.SetDimensions(size.Width, size.Height)
+	}
+
 	var userCmd tea.Cmd
 	if m.app.updateFunc != nil {
 		userCmd = m.app.updateFunc(msg)
 	}
 
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.app.layoutEngine = NewLayoutEngine(msg.Width, msg.Height)
-	case tea.KeyMsg:
-		if msg.Type == tea.KeyCtrlC {
-			return m, tea.Quit
-		}
+	if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyCtrlC {
+		return m, tea.Quit
 	}
 
 	return m, userCmd
 }
 
-// View renders the component tree.
-func (m *model) View() string {
+// renderMu serialises View across apps. A render reads process-wide state set
+// for the app being rendered (the static manager, the no-color setting and the
+// theme), so apps that render concurrently, such as the sessions of an SSH
+// server, take turns.
+var renderMu sync.Mutex
+
+// View renders the component tree. When a box sets a visible Cursor and the
+// app runs on the alternate screen, the output ends with the escape sequence
+// that moves the terminal cursor there.
+func (m *model) View() (view string) {
+	defer m.app.ready()
+	renderMu.Lock()
+	defer renderMu.Unlock()
+
 	SetStaticManager(m.app.staticManager)
 	defer SetStaticManager(nil)
+	setNoColor(m.app.noColor)
+	defer setNoColor(false)
+	setTheme(m.app.theme)
+	defer setTheme(nil)
 
-	root := m.app.rootFunc()
+	if m.app.recovery != nil {
+		defer func() {
+			if err := recover(); err != nil {
+				view = m.app.renderRecovery(err)
+			}
+		}()
+	}
+
+	root := m.app.root()
+	if m.app.validation {
+		m.app.reportValidation(root)
+	}
 	tree := m.app.layoutEngine.CalculateLayout(root)
+	if m.app.debugLayout {
+		applyDebugLayout(tree, 0)
+	}
+	return m.app.frame(tree)
+}
 
-	staticContent := m.app.staticManager.RenderStatic()
+// frame renders tree below the static zone, records where it was drawn and
+// appends the cursor sequence.
+func (a *App) frame(tree *LayoutTree) string {
+	staticContent := a.staticManager.RenderStatic()
 	dynamicContent := renderTree(tree)
 
 	if staticContent == "" {
-		return dynamicContent
+		a.setLayoutTree(tree, 0)
+		return dynamicContent + a.frameCursor(tree, 0)
 	}
+	staticHeight := VisualHeight(staticContent)
+	a.setLayoutTree(tree, staticHeight)
 	if dynamicContent == "" {
 		return staticContent
 	}
-	return staticContent + "\n" + dynamicContent
+	return staticContent + "\n" + dynamicContent + a.frameCursor(tree, staticHeight)
+}
+
+// root builds the component tree with the shortcut footer, if any, wrapped to
+// fill the terminal in fullscreen mode.
+func (a *App) root() Component {
+	root := a.RootFunc()()
+	if len(a.shortcuts) > 0 {
+		root = VStack(root, shortcutFooter(a.shortcuts, a.layoutEngine.TerminalWidth()))
+	}
+	if !a.fullscreen {
+		return root
+	}
+	return Box(BoxProps{
+		Width:  DimensionPercent(100),
+		Height: DimensionPercent(100),
+	}, root)
 }
 
-// renderTree recursively renders a layout tree.
+// renderTree renders a layout tree. Containers such as Box render their own
+// children, so a node whose component has children is rendered on its own.
+// Otherwise the outputs of its tree children follow it.
 func renderTree(tree *LayoutTree) string {
 	if tree == nil {
 		return ""
 	}
 
-	rendered := tree.Component.Render(tree.Layout)
+	rendered, ok := cachedRender(tree.Component, tree.Layout)
+	if !ok {
+		rendered = tree.Component.Render(tree.Layout)
+	}
+	if len(tree.Component.Children()) > 0 {
+		return rendered
+	}
 
+	parts := []string{}
+	if rendered != "" {
+		parts = append(parts, rendered)
+	}
 	for _, child := range tree.Children {
-		childOutput := renderTree(child)
-		if childOutput != "" {
-			rendered += childOutput
+		if childOutput := renderTree(child); childOutput != "" {
+			parts = append(parts, childOutput)
 		}
 	}
 
-	return rendered
+	return strings.Join(parts, "")
 }
 
 // Run starts the Bubble Tea program and blocks until it exits.
+// If a context was set with WithContext, cancelling it quits the program and
+// Run returns the context's error.
 func (a *App) Run() error {
-	p := tea.NewProgram(a.createModel())
-	_, err := p.Run()
-	return err
+	return a.run(a.ctx)
 }
 
-// RunContext starts the Bubble Tea program with a context for graceful shutdown.
+// RunContext starts the Bubble Tea program and blocks until it exits or ctx is
+// cancelled, whichever happens first. After cancellation it returns an error
+// wrapping ctx.Err(). It takes precedence over WithContext.
 func (a *App) RunContext(ctx context.Context) error {
-	p := tea.NewProgram(a.createModel())
-	_, err := p.Run()
-	return err
+	return a.run(ctx)
+}
+
+// run blocks until the program exits and keeps the final frame if requested.
+// When ctx is not nil, the program is started with it so cancellation quits
+// the program.
+func (a *App) run(ctx context.Context) error {
+	p := tea.NewProgram(a.createModel(), a.programOptions(ctx)...)
+	a.setProgram(p)
+	defer a.setProgram(nil)
+
+	final, err := p.Run()
+	if err != nil {
+		if stoppedBy(ctx, err) {
+			return fmt.Errorf("runetui: program stopped: %w", ctx.Err())
+		}
+		return err
+	}
+	if frame := a.finalFrame(final); frame != "" {
+		fmt.Fprintln(a.outputWriter(), frame)
+	}
+	return nil
+}
+
+// stoppedBy reports whether err, returned by the program, means it was
+// stopped because ctx was cancelled rather than quitting or failing on its own.
+func stoppedBy(ctx context.Context, err error) bool {
+	if ctx == nil || ctx.Err() == nil {
+		return false
+	}
+	return errors.Is(err, tea.ErrProgramKilled) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// programOptions returns the Bubble Tea options for a run with ctx, derived
+// from the app's options. ctx may be nil.
+func (a *App) programOptions(ctx context.Context) []tea.ProgramOption {
+	opts := append([]tea.ProgramOption(nil), a.teaOptions...)
+	if a.altScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	if a.mouseSupport {
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	if a.input != nil {
+		opts = append(opts, tea.WithInput(a.input))
+	}
+	if a.output != nil {
+		opts = append(opts, tea.WithOutput(a.output))
+	}
+	if ctx != nil {
+		opts = append(opts, tea.WithContext(ctx))
+	}
+	return opts
+}
+
+// outputWriter returns the writer set with WithIO, or os.Stdout.
+func (a *App) outputWriter() io.Writer {
+	if a.output != nil {
+		return a.output
+	}
+	return os.Stdout
+}
+
+// finalFrame returns the view to leave on screen after exit, or "" when
+// the frame should be cleared or is already on screen.
+func (a *App) finalFrame(final tea.Model) string {
+	if a.clearOnExit || !a.altScreen || final == nil {
+		return ""
+	}
+	return final.View()
 }
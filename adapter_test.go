@@ -1,7 +1,16 @@
 package runetui
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -51,6 +60,22 @@ func TestModel_View_RendersComponentTree(t *testing.T) {
 	}
 }
 
+func TestApp_Model_DrivesUpdateAndView(t *testing.T) {
+	count := 0
+	app := New(func() Component {
+		return Text(fmt.Sprintf("count %d", count))
+	}, WithUpdate(func(msg tea.Msg) tea.Cmd {
+		count++
+		return nil
+	}))
+
+	m, _ := app.Model().Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if output := m.View(); output != "count 1" {
+		t.Errorf("expected %q, got %q", "count 1", output)
+	}
+}
+
 func TestModel_Update_HandlesWindowSizeMsg(t *testing.T) {
 	rootFunc := func() Component {
 		return Text("Hello")
@@ -431,3 +456,727 @@ func TestApp_WithoutUpdateOrInit_WorksAsUsual(t *testing.T) {
 		t.Errorf("expected Update to return nil cmd for non-quit key, got %v", cmd)
 	}
 }
+
+func TestNew_ClearOnExit_DefaultsToTrue(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	if !app.clearOnExit {
+		t.Error("expected clearOnExit to default to true")
+	}
+}
+
+func TestWithClearOnExit_False_DisablesClearing(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithClearOnExit(false))
+
+	if app.clearOnExit {
+		t.Error("expected clearOnExit to be false")
+	}
+}
+
+func TestNew_AltScreen_DefaultsToFalse(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	if app.altScreen {
+		t.Error("expected altScreen to default to false")
+	}
+	if got := len(app.programOptions(nil)); got != 0 {
+		t.Errorf("expected no program options, got %d", got)
+	}
+}
+
+func TestWithAltScreen_AddsProgramOption(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithAltScreen())
+
+	if !app.altScreen {
+		t.Error("expected altScreen to be true")
+	}
+	if got := len(app.programOptions(nil)); got != 1 {
+		t.Errorf("expected 1 program option, got %d", got)
+	}
+}
+
+func TestWithNoAltScreen_OverridesWithAltScreen(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithAltScreen(), WithNoAltScreen())
+
+	if app.altScreen {
+		t.Error("expected WithNoAltScreen to disable the alternate screen")
+	}
+}
+
+func TestWithMouseSupport_AddsProgramOption(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithMouseSupport())
+
+	if !app.mouseSupport {
+		t.Error("expected mouseSupport to be true")
+	}
+	if got := len(app.programOptions(nil)); got != 1 {
+		t.Errorf("expected 1 program option, got %d", got)
+	}
+}
+
+func TestApp_LayoutTree_RecordsLastRender(t *testing.T) {
+	app := New(func() Component { return Box(BoxProps{Key: "root"}, Text("Hello")) })
+
+	if app.LayoutTree() != nil {
+		t.Error("expected no layout tree before the first render")
+	}
+	app.createModel().View()
+
+	tree := app.LayoutTree()
+	if tree == nil || tree.Component.Key() != "root" {
+		t.Errorf("expected the rendered tree rooted at %q, got %v", "root", tree)
+	}
+}
+
+func TestWithProgramOptions_PrecedesDerivedOptions(t *testing.T) {
+	app := New(func() Component { return Text("Hello") },
+		WithProgramOptions(tea.WithoutRenderer(), tea.WithFPS(30)), WithAltScreen())
+
+	opts := app.programOptions(nil)
+
+	if len(opts) != 3 {
+		t.Errorf("expected the 2 program options followed by the alt screen, got %d options", len(opts))
+	}
+}
+
+func TestApp_ProgramOptions_DoesNotModifyTeaOptions(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithAltScreen())
+	app.teaOptions = make([]tea.ProgramOption, 1, 4)
+	app.teaOptions[0] = tea.WithoutRenderer()
+
+	app.programOptions(context.Background())
+
+	if len(app.teaOptions) != 1 {
+		t.Errorf("expected teaOptions to keep 1 option, got %d", len(app.teaOptions))
+	}
+}
+
+func TestApp_FinalFrame_WhenClearing_ReturnsEmpty(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	frame := app.finalFrame(app.createModel())
+
+	if frame != "" {
+		t.Errorf("expected empty final frame, got %q", frame)
+	}
+}
+
+func TestApp_FinalFrame_WhenNotClearing_ReturnsLastView(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithClearOnExit(false), WithAltScreen())
+
+	frame := app.finalFrame(app.createModel())
+
+	if frame != "Hello" {
+		t.Errorf("expected final frame 'Hello', got %q", frame)
+	}
+}
+
+func TestApp_FinalFrame_WhenNotClearingInline_ReturnsEmpty(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithClearOnExit(false))
+
+	frame := app.finalFrame(app.createModel())
+
+	if frame != "" {
+		t.Errorf("expected inline final frame not to be printed again, got %q", frame)
+	}
+}
+
+func TestApp_FinalFrame_WithNilModel_ReturnsEmpty(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithClearOnExit(false), WithAltScreen())
+
+	frame := app.finalFrame(nil)
+
+	if frame != "" {
+		t.Errorf("expected empty final frame, got %q", frame)
+	}
+}
+
+func TestApp_Printf_AppendsFormattedLinesToStaticZone(t *testing.T) {
+	app := New(func() Component { return Text("Dynamic") })
+
+	app.Printf("downloaded %d of %d\nnext: %s", 1, 3, "b.txt")
+
+	got := app.staticManager.RenderStatic()
+	expected := "downloaded 1 of 3\nnext: b.txt"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestApp_Printf_WithTrailingNewline_DoesNotAddEmptyLine(t *testing.T) {
+	app := New(func() Component { return Text("Dynamic") })
+
+	app.Printf("done\n")
+
+	got := app.staticManager.RenderStatic()
+	if got != "done" {
+		t.Errorf("expected %q, got %q", "done", got)
+	}
+}
+
+func TestApp_Printf_OutputAppearsAboveDynamicContent(t *testing.T) {
+	app := New(func() Component { return Text("Dynamic") })
+	m := app.createModel()
+
+	app.Printf("log line")
+
+	output := m.View()
+	expected := "log line\nDynamic"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestModel_Update_RefreshMsg_IsNotForwardedToUpdateFunc(t *testing.T) {
+	var called bool
+	updateFunc := func(msg tea.Msg) tea.Cmd {
+		called = true
+		return nil
+	}
+
+	app := New(func() Component { return Text("Hello") }, WithUpdate(updateFunc))
+	m := app.createModel().(*model)
+
+	_, cmd := m.Update(refreshMsg{})
+
+	if called {
+		t.Error("expected refreshMsg to stay internal")
+	}
+	if cmd != nil {
+		t.Errorf("expected nil cmd, got %v", cmd)
+	}
+}
+
+func TestModel_Update_WindowSizeMsg_ResizesLayoutBeforeUpdateFunc(t *testing.T) {
+	var app *App
+	var seenWidth, seenHeight int
+	updateFunc := func(msg tea.Msg) tea.Cmd {
+		if _, ok := msg.(tea.WindowSizeMsg); ok {
+			seenWidth = app.layoutEngine.terminalWidth
+			seenHeight = app.layoutEngine.terminalHeight
+		}
+		return nil
+	}
+
+	app = New(func() Component { return Text("Hello") }, WithUpdate(updateFunc))
+	m := app.createModel().(*model)
+
+	m.Update(tea.WindowSizeMsg{Width: 132, Height: 43})
+
+	if seenWidth != 132 || seenHeight != 43 {
+		t.Errorf("expected UpdateFunc to see 132x43 layout, got %dx%d", seenWidth, seenHeight)
+	}
+}
+
+func TestModel_Update_WindowSizeMsg_ReturnsUserCommand(t *testing.T) {
+	updateFunc := func(msg tea.Msg) tea.Cmd {
+		if _, ok := msg.(tea.WindowSizeMsg); ok {
+			return func() tea.Msg { return "resized" }
+		}
+		return nil
+	}
+
+	app := New(func() Component { return Text("Hello") }, WithUpdate(updateFunc))
+	m := app.createModel().(*model)
+
+	_, cmd := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	if cmd == nil {
+		t.Fatal("expected user command for resize")
+	}
+	if got := cmd(); got != "resized" {
+		t.Errorf("expected 'resized', got %v", got)
+	}
+}
+
+func TestApp_Accessors_ReturnConfiguredFunctions(t *testing.T) {
+	rootCalled, updateCalled, initCalled := false, false, false
+	app := New(
+		func() Component { rootCalled = true; return Text("Hello") },
+		WithUpdate(func(msg tea.Msg) tea.Cmd { updateCalled = true; return nil }),
+		WithInit(func() tea.Cmd { initCalled = true; return nil }),
+	)
+
+	app.RootFunc()()
+	app.UpdateFunc()(nil)
+	app.InitFunc()()
+
+	if !rootCalled || !updateCalled || !initCalled {
+		t.Errorf("expected all accessors to return configured functions: root=%v update=%v init=%v", rootCalled, updateCalled, initCalled)
+	}
+}
+
+func TestApp_Accessors_WithoutOptions_ReturnNil(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	if app.UpdateFunc() != nil || app.InitFunc() != nil {
+		t.Error("expected nil update and init functions by default")
+	}
+}
+
+func TestApp_OnReady_CalledOnceAfterFirstRender(t *testing.T) {
+	var calls int32
+	done := make(chan struct{}, 2)
+	app := New(func() Component { return Text("Hello") })
+	app.OnReady(func() {
+		atomic.AddInt32(&calls, 1)
+		done <- struct{}{}
+	})
+	m := app.createModel()
+
+	m.View()
+	m.View()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected OnReady callback to be called")
+	}
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestApp_OnReady_NotCalledBeforeRender(t *testing.T) {
+	called := make(chan struct{}, 1)
+	app := New(func() Component { return Text("Hello") })
+	app.OnReady(func() { called <- struct{}{} })
+
+	select {
+	case <-called:
+		t.Error("expected no call before the first render")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestWithFullscreen_RootFillsTerminal(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithFullscreen(true))
+	m := app.createModel()
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	tree := app.layoutEngine.CalculateLayout(app.root())
+
+	if tree.Layout.Width != 100 || tree.Layout.Height != 30 {
+		t.Errorf("expected root to fill 100x30, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+	if m.View() == "" {
+		t.Error("expected non-empty view")
+	}
+}
+
+func TestWithFullscreen_Default_SizesToContent(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+	m := app.createModel()
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	tree := app.layoutEngine.CalculateLayout(app.root())
+
+	if tree.Layout.Width != 5 || tree.Layout.Height != 1 {
+		t.Errorf("expected root sized to content 5x1, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+}
+
+func TestApp_SetRootFunc_ReplacesRenderedTree(t *testing.T) {
+	app := New(func() Component { return Text("Page 1") })
+	m := app.createModel()
+
+	app.SetRootFunc(func() Component { return Text("Page 2") })
+
+	if output := m.View(); output != "Page 2" {
+		t.Errorf("expected %q, got %q", "Page 2", output)
+	}
+}
+
+func TestApp_SetRootFunc_ConcurrentWithView(t *testing.T) {
+	app := New(func() Component { return Text("A") })
+	m := app.createModel()
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < 100; i++ {
+			app.SetRootFunc(func() Component { return Text("B") })
+		}
+		close(done)
+	}()
+	for i := 0; i < 100; i++ {
+		m.View()
+	}
+	<-done
+
+	if output := m.View(); output != "B" {
+		t.Errorf("expected %q, got %q", "B", output)
+	}
+}
+
+type counterState struct {
+	Count int
+}
+
+func TestWithInitialModel_StateReturnsTypedValue(t *testing.T) {
+	app := New(func() Component { return Text("") }, WithInitialModel(counterState{Count: 3}))
+
+	state := State[counterState](app)
+
+	if state.Count != 3 {
+		t.Errorf("expected Count 3, got %d", state.Count)
+	}
+}
+
+func TestWithInitialModel_PointerState_SharesMutations(t *testing.T) {
+	app := New(func() Component { return Text("") }, WithInitialModel(&counterState{}))
+
+	State[*counterState](app).Count++
+
+	if got := State[*counterState](app).Count; got != 1 {
+		t.Errorf("expected Count 1, got %d", got)
+	}
+}
+
+func TestState_UnsetOrMismatchedType_ReturnsZeroValue(t *testing.T) {
+	unset := New(func() Component { return Text("") })
+	mismatched := New(func() Component { return Text("") }, WithInitialModel("text"))
+
+	if state := State[counterState](unset); state != (counterState{}) {
+		t.Errorf("expected zero value for unset state, got %+v", state)
+	}
+	if state := State[*counterState](mismatched); state != nil {
+		t.Errorf("expected nil for mismatched type, got %+v", state)
+	}
+}
+
+// headlessOptions run a program without a terminal.
+func headlessOptions() []tea.ProgramOption {
+	return []tea.ProgramOption{tea.WithInput(nil), tea.WithOutput(io.Discard)}
+}
+
+func runWithTimeout(t *testing.T, run func() error) error {
+	t.Helper()
+	result := make(chan error, 1)
+	go func() { result <- run() }()
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Run to return promptly after cancellation")
+		return nil
+	}
+}
+
+func TestWithContext_Cancel_RunReturnsPromptly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	app := New(func() Component { return Text("Hello") }, WithContext(ctx))
+	app.teaOptions = headlessOptions()
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	if err := runWithTimeout(t, app.Run); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestRunContext_Cancel_ReturnsPromptly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	app := New(func() Component { return Text("Hello") })
+	app.teaOptions = headlessOptions()
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	if err := runWithTimeout(t, func() error { return app.RunContext(ctx) }); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestRunContext_AlreadyCancelled_ReturnsPromptly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	app := New(func() Component { return Text("Hello") })
+	app.teaOptions = headlessOptions()
+
+	err := runWithTimeout(t, func() error { return app.RunContext(ctx) })
+	if err == nil {
+		t.Fatal("expected an error for a cancelled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the error to wrap context.Canceled, got %v", err)
+	}
+}
+
+func TestRunContext_DeadlineExceeded_WrapsContextError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	app := New(func() Component { return Text("Hello") })
+	app.teaOptions = headlessOptions()
+
+	if err := runWithTimeout(t, func() error { return app.RunContext(ctx) }); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestApp_Refresh_NotRunning_DoesNothing(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	app.Refresh()
+}
+
+func TestApp_Refresh_RendersAgainWithoutUpdate(t *testing.T) {
+	var renders, updates int32
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := New(func() Component {
+		atomic.AddInt32(&renders, 1)
+		return Text("Hello")
+	}, WithUpdate(func(msg tea.Msg) tea.Cmd {
+		atomic.AddInt32(&updates, 1)
+		return nil
+	}))
+	app.teaOptions = headlessOptions()
+	ready := make(chan struct{})
+	app.OnReady(func() { close(ready) })
+
+	result := make(chan error, 1)
+	go func() { result <- app.RunContext(ctx) }()
+	<-ready
+	before := atomic.LoadInt32(&renders)
+	updatesBefore := atomic.LoadInt32(&updates)
+
+	app.Refresh()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for atomic.LoadInt32(&renders) <= before && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&renders) <= before {
+		t.Error("expected render count to increase after Refresh")
+	}
+	if atomic.LoadInt32(&updates) != updatesBefore {
+		t.Error("expected Refresh not to call the UpdateFunc")
+	}
+	cancel()
+	<-result
+}
+
+func TestApp_SendMessage_NotRunning_DoesNothing(t *testing.T) {
+	called := false
+	app := New(func() Component { return Text("Hello") }, WithUpdate(func(msg tea.Msg) tea.Cmd {
+		called = true
+		return nil
+	}))
+
+	app.SendMessage("ping")
+
+	if called {
+		t.Error("expected SendMessage not to call the UpdateFunc when the app is not running")
+	}
+}
+
+func TestApp_SendMessage_ReachesUpdateFunc(t *testing.T) {
+	type pingMsg struct{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan struct{}, 1)
+	app := New(func() Component { return Text("Hello") }, WithUpdate(func(msg tea.Msg) tea.Cmd {
+		if _, ok := msg.(pingMsg); ok {
+			received <- struct{}{}
+		}
+		return nil
+	}))
+	app.teaOptions = headlessOptions()
+	ready := make(chan struct{})
+	app.OnReady(func() { close(ready) })
+
+	result := make(chan error, 1)
+	go func() { result <- app.RunContext(ctx) }()
+	<-ready
+
+	app.SendMessage(pingMsg{})
+
+	select {
+	case <-received:
+	case <-time.After(2 * time.Second):
+		t.Error("expected the UpdateFunc to receive the message")
+	}
+	cancel()
+	<-result
+}
+
+func TestApp_SendMessage_DeliversInOrder(t *testing.T) {
+	type seqMsg int
+	const count = 100
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan int, count)
+	app := New(func() Component { return Text("Hello") }, WithUpdate(func(msg tea.Msg) tea.Cmd {
+		if seq, ok := msg.(seqMsg); ok {
+			received <- int(seq)
+		}
+		return nil
+	}))
+	app.teaOptions = headlessOptions()
+	ready := make(chan struct{})
+	app.OnReady(func() { close(ready) })
+
+	result := make(chan error, 1)
+	go func() { result <- app.RunContext(ctx) }()
+	<-ready
+
+	for i := 0; i < count; i++ {
+		app.SendMessage(seqMsg(i))
+	}
+
+	for i := 0; i < count; i++ {
+		select {
+		case got := <-received:
+			if got != i {
+				t.Fatalf("expected message %d, got %d", i, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected message %d to be delivered", i)
+		}
+	}
+	// Quit through the queue rather than cancelling, which could interrupt
+	// the Update that delivered the last message.
+	app.SendMessage(tea.QuitMsg{})
+	<-result
+}
+
+func TestRenderTree_ColumnBox_RendersChildrenOnceOnSeparateLines(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Column}, Text("A"), Text("B")))
+
+	output := StripANSI(renderTree(tree))
+
+	if output != "A\nB" {
+		t.Errorf("expected %q, got %q", "A\nB", output)
+	}
+}
+
+func TestApp_StaticManager_ReturnsAppManager(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	app.Printf("line")
+
+	if got := app.StaticManager().RenderStatic(); got != "line" {
+		t.Errorf("expected the app's static content, got %q", got)
+	}
+}
+
+func TestWithSize_SetsLayoutDimensions(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSize(120, 40))
+
+	if app.layoutEngine.TerminalWidth() != 120 || app.layoutEngine.TerminalHeight() != 40 {
+		t.Errorf("expected 120x40, got %dx%d", app.layoutEngine.TerminalWidth(), app.layoutEngine.TerminalHeight())
+	}
+}
+
+func TestWithIO_ProgramOptionsIncludeInputAndOutput(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithIO(strings.NewReader(""), io.Discard))
+
+	if got := len(app.programOptions(nil)); got != 2 {
+		t.Errorf("expected 2 program options, got %d", got)
+	}
+}
+
+func TestWithIO_FinalFrameWrittenToOutput(t *testing.T) {
+	var out bytes.Buffer
+	app := New(func() Component { return Text("Hello") },
+		WithIO(nil, &out), WithAltScreen(), WithClearOnExit(false),
+		WithProgramOptions(tea.WithInput(nil), tea.WithoutRenderer()))
+	app.OnReady(func() { app.SendMessage(tea.Quit()) })
+
+	if err := runWithTimeout(t, app.Run); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasSuffix(out.String(), "Hello\n") {
+		t.Errorf("expected the final frame on the output writer, got %q", out.String())
+	}
+}
+
+func TestApp_Session_CopiesOptionsWithOwnState(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithAltScreen(), WithSize(100, 30))
+	app.StaticManager().Append("log line")
+
+	session := app.Session(WithSize(60, 20))
+
+	if !session.altScreen {
+		t.Error("expected the session to keep WithAltScreen")
+	}
+	if session.layoutEngine == app.layoutEngine || session.staticManager == app.staticManager {
+		t.Error("expected the session to have its own layout engine and static manager")
+	}
+	if session.layoutEngine.TerminalWidth() != 60 || app.layoutEngine.TerminalWidth() != 100 {
+		t.Errorf("expected session width 60 and app width 100, got %d and %d",
+			session.layoutEngine.TerminalWidth(), app.layoutEngine.TerminalWidth())
+	}
+	if session.StaticManager().LineCount() != 0 {
+		t.Errorf("expected an empty static zone, got %d lines", session.StaticManager().LineCount())
+	}
+}
+
+func TestApp_Session_SlogHandler_LogsToSessionStaticZone(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+	session := app.Session()
+
+	AppSlogLogger(session).Info("session event")
+
+	if session.StaticManager().LineCount() != 1 || app.StaticManager().LineCount() != 0 {
+		t.Errorf("expected the log line in the session's static zone only, got %d and %d lines",
+			session.StaticManager().LineCount(), app.StaticManager().LineCount())
+	}
+}
+
+func TestApp_Session_ConcurrentViews_KeepStateSeparate(t *testing.T) {
+	app := New(func() Component {
+		return VStack(
+			Static(StaticProps{Key: "log"}, func() []Component { return []Component{Text("log line")} }),
+			Text("theme "+CurrentTheme().Primary),
+		)
+	})
+
+	var wg sync.WaitGroup
+	for _, primary := range []string{"#111111", "#222222"} {
+		theme := DefaultTheme()
+		theme.Primary = primary
+		session := app.Session(WithTheme(theme))
+		m := session.createModel()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				if output := m.View(); !strings.Contains(output, "theme "+primary) {
+					t.Errorf("expected the session's own theme %s, got %q", primary, output)
+					return
+				}
+			}
+			if got := session.StaticManager().LineCount(); got != 1 {
+				t.Errorf("expected the static line once in the session's own zone, got %d lines", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestStoppedBy_OnlyContextStopsWithCancelledContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	failure := errors.New("terminal failed")
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"killed with cancelled context", cancelled, tea.ErrProgramKilled, true},
+		{"context error with cancelled context", cancelled, context.Canceled, true},
+		{"other error with cancelled context", cancelled, failure, false},
+		{"killed with live context", context.Background(), tea.ErrProgramKilled, false},
+		{"killed without context", nil, tea.ErrProgramKilled, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := stoppedBy(tc.ctx, tc.err); got != tc.want {
+				t.Errorf("stoppedBy() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package runetui
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -78,3 +79,48 @@ func AssertNotEmpty(t testing.TB, output string) {
 		t.Errorf("expected non-empty output, got: %q", output)
 	}
 }
+
+// AssertContainsAt verifies that text appears in the visible output starting
+// at column x of line y, both counted from 0 in characters. ANSI codes are
+// ignored. On failure the message shows the output as a numbered grid.
+//
+// Example:
+//
+//	AssertContainsAt(t, output, 0, 0, "┌")
+//	AssertContainsAt(t, output, 1, 1, "Hello")
+func AssertContainsAt(t testing.TB, output string, x, y int, text string) {
+	t.Helper()
+	lines := strings.Split(StripANSI(output), "\n")
+	if y < 0 || y >= len(lines) {
+		t.Errorf("line %d out of range: output has %d lines\n%s", y, len(lines), grid(lines))
+		return
+	}
+	line := []rune(lines[y])
+	end := x + len([]rune(text))
+	if x < 0 || end > len(line) {
+		t.Errorf("expected %q at (%d, %d), but line %d is %d characters wide\n%s", text, x, y, y, len(line), grid(lines))
+		return
+	}
+	if got := string(line[x:end]); got != text {
+		t.Errorf("expected %q at (%d, %d), got %q\n%s", text, x, y, got, grid(lines))
+	}
+}
+
+// grid formats lines with a column ruler and line numbers, for failure
+// messages about positions.
+func grid(lines []string) string {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len([]rune(line)))
+	}
+	var sb strings.Builder
+	sb.WriteString("    ")
+	for i := 0; i < width; i++ {
+		sb.WriteByte(byte('0' + i%10))
+	}
+	sb.WriteByte('\n')
+	for i, line := range lines {
+		sb.WriteString(fmt.Sprintf("%3d|%s\n", i, line))
+	}
+	return sb.String()
+}
@@ -1,6 +1,10 @@
 package runetui
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestStripANSI_WithBoldCode_RemovesIt(t *testing.T) {
 	input := "\x1b[1mBold\x1b[0m"
@@ -155,3 +159,60 @@ func TestAssertNotEmpty_CanBeCalled(t *testing.T) {
 	output := "Hello"
 	AssertNotEmpty(t, output)
 }
+
+// failureRecorder captures assertion failures so failing paths can be tested.
+type failureRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAssertContainsAt_BorderedBox_FindsCornersAndText(t *testing.T) {
+	tree := NewLayoutEngine(20, 5).CalculateLayout(Box(BoxProps{Border: BorderSingle}, Text("Hello")))
+	output := renderTree(tree)
+
+	AssertContainsAt(t, output, 0, 0, "┌")
+	AssertContainsAt(t, output, 6, 0, "┐")
+	AssertContainsAt(t, output, 1, 1, "Hello")
+	AssertContainsAt(t, output, 0, 2, "└─────┘")
+}
+
+func TestAssertContainsAt_WrongPosition_ShowsGrid(t *testing.T) {
+	rec := &failureRecorder{TB: t}
+
+	AssertContainsAt(rec, "ab\ncd", 0, 1, "d")
+
+	if len(rec.failures) != 1 {
+		t.Fatalf("expected 1 failure, got %v", rec.failures)
+	}
+	if !strings.Contains(rec.failures[0], `got "c"`) || !strings.Contains(rec.failures[0], "  1|cd") {
+		t.Errorf("expected the actual text and grid, got %q", rec.failures[0])
+	}
+}
+
+func TestAssertContainsAt_OutOfBounds_Fails(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y int
+	}{
+		{"line below output", 0, 2},
+		{"text past line end", 1, 0},
+		{"negative column", -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &failureRecorder{TB: t}
+
+			AssertContainsAt(rec, "ab\ncd", tt.x, tt.y, "ab")
+
+			if len(rec.failures) != 1 {
+				t.Errorf("expected 1 failure, got %v", rec.failures)
+			}
+		})
+	}
+}
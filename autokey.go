@@ -0,0 +1,98 @@
+package runetui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AutoKey returns c with a positional key such as "text-2" when c has no key
+// of its own. The key is built from the component's type name and index, its
+// position among its siblings, so it stays stable across renders as long as
+// the sibling order does. Components that already have a key are returned
+// unchanged.
+//
+// Built-in components are copied with the key set in their props, so layout
+// treats them as before; other components are wrapped in a component that
+// forwards their optional interfaces.
+func AutoKey(c Component, index int) Component {
+	if c == nil || c.Key() != "" {
+		return c
+	}
+
+	key := fmt.Sprintf("%s-%d", componentTypeName(c), index)
+	switch v := c.(type) {
+	case *box:
+		props := v.props
+		props.Key = key
+		return &box{props: props, children: v.children}
+	case *text:
+		props := v.props
+		props.Key = key
+		return &text{content: v.content, props: props}
+	case *static:
+		return &static{props: StaticProps{Key: key}, itemsFunc: v.itemsFunc}
+	default:
+		return keyed{Component: c, key: key}
+	}
+}
+
+// componentTypeName returns the lowercase type name of c without any pointer.
+func componentTypeName(c Component) string {
+	t := reflect.TypeOf(c)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Name() == "" {
+		return "component"
+	}
+	return strings.ToLower(t.Name())
+}
+
+// keyed overrides the key of a component it wraps. It implements every
+// optional interface and forwards each to the wrapped component, answering as
+// a component without that interface would when the wrapped one lacks it.
+type keyed struct {
+	Component
+	key string
+}
+
+func (k keyed) Key() string {
+	return k.key
+}
+
+// Dirty reports whether the wrapped component must be rendered again.
+func (k keyed) Dirty() bool {
+	return isDirty(k.Component)
+}
+
+// lastRender returns the wrapped component's memoized output, if any.
+func (k keyed) lastRender(layout Layout) (string, bool) {
+	if m, ok := k.Component.(renderMemoizer); ok {
+		return m.lastRender(layout)
+	}
+	return "", false
+}
+
+// Validate returns the wrapped component's validation error, if any.
+func (k keyed) Validate() error {
+	if v, ok := k.Component.(Validatable); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// Clone returns a wrapper with the same key around a clone of the component.
+func (k keyed) Clone() Component {
+	return keyed{Component: CloneTree(k.Component), key: k.key}
+}
+
+// measureIn measures the wrapped component as a child of a box in direction.
+func (k keyed) measureIn(direction Direction, availableWidth, availableHeight int) Size {
+	return measureChild(k.Component, direction, availableWidth, availableHeight)
+}
+
+// label names the wrapped component's kind.
+func (k keyed) label() string {
+	return componentLabel(k.Component)
+}
@@ -0,0 +1,105 @@
+package runetui
+
+import "testing"
+
+func TestAutoKey_BuiltinComponents_GetPositionalKeys(t *testing.T) {
+	tests := []struct {
+		component Component
+		index     int
+		expected  string
+	}{
+		{Text("hi"), 0, "text-0"},
+		{Box(BoxProps{}), 3, "box-3"},
+		{Static(StaticProps{}, func() []Component { return nil }), 1, "static-1"},
+	}
+
+	for _, tt := range tests {
+		if got := AutoKey(tt.component, tt.index).Key(); got != tt.expected {
+			t.Errorf("expected key %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+func TestAutoKey_ExistingKey_ReturnsComponentUnchanged(t *testing.T) {
+	c := Text("hi", TextProps{Key: "greeting"})
+
+	if AutoKey(c, 5) != c {
+		t.Error("expected keyed component to be returned unchanged")
+	}
+}
+
+func TestAutoKey_Box_KeepsLayoutProps(t *testing.T) {
+	b := Box(BoxProps{Padding: SpacingAll(1), Border: BorderSingle}, Text("a"))
+
+	keyed := AutoKey(b, 0)
+
+	engine := NewLayoutEngine(80, 24)
+	original := engine.CalculateLayout(b)
+	got := engine.CalculateLayout(keyed)
+	if got.Layout != original.Layout || got.Children[0].Layout != original.Children[0].Layout {
+		t.Errorf("expected same layout, got %+v want %+v", got.Layout, original.Layout)
+	}
+	if b.Key() != "" {
+		t.Error("expected original box to keep its empty key")
+	}
+}
+
+func TestAutoKey_CustomComponent_IsWrapped(t *testing.T) {
+	c := ComponentFunc(func() Component { return Text("x") })
+
+	keyed := AutoKey(c, 2)
+
+	if keyed.Key() != "componentfunc-2" {
+		t.Errorf("expected key %q, got %q", "componentfunc-2", keyed.Key())
+	}
+	if keyed.Render(Layout{Width: 1, Height: 1}) != "x" {
+		t.Error("expected wrapper to delegate rendering")
+	}
+}
+
+func TestAutoKey_Nil_ReturnsNil(t *testing.T) {
+	if AutoKey(nil, 0) != nil {
+		t.Error("expected nil")
+	}
+}
+
+func TestAutoKey_Wrapped_ForwardsOptionalInterfaces(t *testing.T) {
+	spacer := AutoKey(DirectionalSpacer(2), 1)
+	if spacer.Key() != "directionalspacer-1" {
+		t.Fatalf("expected a wrapped spacer, got key %q", spacer.Key())
+	}
+
+	if got := measureChild(spacer, Row, 80, 24); got != (Size{Width: 2}) {
+		t.Errorf("expected the spacer to measure along the row, got %+v", got)
+	}
+	if got := spacer.(labeler).label(); got != "spacer" {
+		t.Errorf("expected label %q, got %q", "spacer", got)
+	}
+	if _, ok := CloneTree(spacer).(keyed); !ok || CloneTree(spacer).Key() != spacer.Key() {
+		t.Errorf("expected a clone with the same key, got %#v", CloneTree(spacer))
+	}
+}
+
+func TestAutoKey_Wrapped_ForwardsDirtyAndValidate(t *testing.T) {
+	inner := &checkedComponent{err: ErrInvalidProps}
+	c := AutoKey(inner, 0)
+
+	if isDirty(c) {
+		t.Error("expected a clean component to stay clean when wrapped")
+	}
+	if errs := ValidateTree(c); len(errs) != 1 || errs[0] != ErrInvalidProps {
+		t.Errorf("expected the wrapped validation error, got %v", errs)
+	}
+	if !isDirty(AutoKey(&mockComponent{}, 0)) {
+		t.Error("expected a component without DirtyChecker to stay dirty when wrapped")
+	}
+}
+
+// checkedComponent is a clean component that reports a fixed validation error.
+type checkedComponent struct {
+	mockComponent
+	err error
+}
+
+func (c *checkedComponent) Dirty() bool     { return false }
+func (c *checkedComponent) Validate() error { return c.err }
@@ -7,82 +7,443 @@ import (
 )
 
 // BoxProps defines the properties for a Box component.
+// Gap is the space between children on either axis; GapX and GapY, when set,
+// override it for Row and Column boxes respectively.
+// CollapseAdjacentBorders draws the shared edge of adjacent bordered children
+// once, joining their borders with junctions such as '├' and '┴'.
+// BorderTitle is drawn in the top border, placed by BorderTitleAlign, and is
+// ignored without a border.
+// BorderTop, BorderRight, BorderBottom and BorderLeft draw single-line borders
+// on individual sides when Border is BorderNone.
 type BoxProps struct {
-	Direction      Direction
-	Width          Dimension
-	Height         Dimension
-	MinWidth       int
-	MinHeight      int
-	MaxWidth       int
-	MaxHeight      int
-	FlexGrow       float64
-	FlexShrink     float64
-	AlignItems     Align
-	JustifyContent Justify
-	Padding        Spacing
-	Margin         Spacing
-	Gap            int
-	Border         BorderStyle
-	BorderColor    string
-	Background     string
-	IsStatic       bool
-	Key            string
+	Direction               Direction
+	Width                   Dimension
+	Height                  Dimension
+	MinWidth                int
+	MinHeight               int
+	MaxWidth                int
+	MaxHeight               int
+	FlexGrow                float64
+	FlexShrink              float64
+	AlignItems              Align
+	JustifyContent          Justify
+	Padding                 Spacing
+	Margin                  Spacing
+	Gap                     int
+	GapX                    int
+	GapY                    int
+	Border                  BorderStyle
+	BorderColor             string
+	BorderTop               bool
+	BorderRight             bool
+	BorderBottom            bool
+	BorderLeft              bool
+	BorderTitle             string
+	BorderTitleAlign        TextAlign
+	CollapseAdjacentBorders bool
+	Background              string
+	Overflow                OverflowMode
+	ScrollOffset            int
+	Cursor                  CursorPosition
+	IsStatic                bool
+	Key                     string
 }
 
 func (BoxProps) isProps() {}
 
+// mainGap returns the space between children along the main axis: GapX in a
+// Row and GapY in a Column, falling back to Gap when the axis gap is zero.
+func (p BoxProps) mainGap() int {
+	gap := p.GapY
+	if p.Direction == Row {
+		gap = p.GapX
+	}
+	if gap == 0 {
+		return p.Gap
+	}
+	return gap
+}
+
 // box is the private implementation of the Box component.
 type box struct {
 	props    BoxProps
 	children []Component
+	memo     renderMemo[BoxProps]
 }
 
 // Box creates a new Box component with the given properties and children.
+// Nil children are dropped, so conditionally rendered components can be passed
+// directly: Box(props, maybeNil, Text("always")).
 func Box(props BoxProps, children ...Component) Component {
-	if children == nil {
-		children = []Component{}
-	}
 	return &box{
 		props:    props,
-		children: children,
+		children: withoutNil(children),
+	}
+}
+
+// SpacingBox returns BoxProps with the given padding on each side, in CSS
+// order. Set further fields on the result before passing it to Box.
+func SpacingBox(top, right, bottom, left int) BoxProps {
+	return BoxProps{Padding: SpacingCSSLike(top, right, bottom, left)}
+}
+
+// PaddedBox creates a column Box with the given padding around its children.
+func PaddedBox(padding Spacing, children ...Component) Component {
+	return Box(BoxProps{Padding: padding}, children...)
+}
+
+// withoutNil returns children without nil entries, reusing the slice when
+// there are none so layout caching keyed on the children still applies.
+func withoutNil(children []Component) []Component {
+	if children == nil {
+		return []Component{}
+	}
+	for i, child := range children {
+		if child == nil {
+			return appendNonNil(children[:i:i], children[i+1:])
+		}
 	}
+	return children
+}
+
+// appendNonNil appends the non-nil entries of children to kept.
+func appendNonNil(kept, children []Component) []Component {
+	for _, child := range children {
+		if child != nil {
+			kept = append(kept, child)
+		}
+	}
+	return kept
 }
 
 // Render generates the string representation of the box.
 func (b *box) Render(layout Layout) string {
+	output := b.render(layout)
+	b.memo.store(b.props, layout, output)
+	return output
+}
+
+// Dirty reports whether the props changed since the last render or any child is dirty.
+func (b *box) Dirty() bool {
+	if b.memo.dirty(b.props) {
+		return true
+	}
+	for _, child := range b.children {
+		if isDirty(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *box) lastRender(layout Layout) (string, bool) {
+	return b.memo.lookup(layout)
+}
+
+func (b *box) render(layout Layout) string {
 	if len(b.children) == 0 {
-		return ""
+		return b.renderEmpty(layout)
 	}
 
-	var parts []string
-	for _, child := range b.children {
-		childLayout := Layout{
-			X:      layout.X,
-			Y:      layout.Y,
-			Width:  layout.Width,
-			Height: layout.Height,
+	// Children are drawn inside the border so the box keeps its layout size.
+	borderWidth, borderHeight := borderSize(b.props)
+	borderLeft, borderTop := borderOffset(b.props)
+	inner := Layout{
+		X:      layout.X + borderLeft,
+		Y:      layout.Y + borderTop,
+		Width:  max(0, layout.Width-borderWidth),
+		Height: max(0, layout.Height-borderHeight),
+	}
+
+	// Columns only need their children's sizes when one of them grows or
+	// they are justified.
+	var sizes []Size
+	var grows []int
+	leads := make([]int, len(b.children))
+	if b.props.Direction == Row || b.props.JustifyContent != JustifyStart || hasGrowingChild(b.children, b.props.Direction) {
+		sizes = make([]Size, len(b.children))
+		for i, child := range b.children {
+			sizes[i] = measureChild(child, b.props.Direction, inner.Width, inner.Height)
+		}
+		grows = growAmounts(b.props, b.children, sizes, layout)
+		for i, grow := range grows {
+			if b.props.Direction == Row {
+				sizes[i].Width += grow
+			} else {
+				sizes[i].Height += grow
+			}
 		}
-		parts = append(parts, child.Render(childLayout))
+		leads = b.justifyLeads(sizes, layout)
 	}
 
 	var content string
 	if b.props.Direction == Row {
-		content = strings.Join(parts, "")
+		content = b.renderRow(inner, sizes, leads)
 	} else {
-		content = strings.Join(parts, "\n")
+		var parts []string
+		for i, child := range b.children {
+			var rendered string
+			if grows == nil || grows[i] == 0 {
+				rendered = child.Render(inner)
+			} else {
+				height := sizes[i].Height
+				rendered = padBlock(child.Render(Layout{X: inner.X, Y: inner.Y, Width: inner.Width, Height: height}), 0, height)
+			}
+			parts = append(parts, strings.Repeat("\n", leads[i])+rendered)
+		}
+		content = joinColumn(b.props, b.children, parts)
+	}
+	return b.decorate(content, layout)
+}
+
+// justifyLeads returns the blank cells JustifyContent adds on the main axis
+// before each child, placing children where the layout engine does.
+func (b *box) justifyLeads(sizes []Size, layout Layout) []int {
+	leads := make([]int, len(b.children))
+	if b.props.JustifyContent == JustifyStart {
+		return leads
+	}
+
+	borderWidth, borderHeight := borderSize(b.props)
+	mainSize := layout.Height - borderHeight - spacingHeight(b.props.Padding) - spacingHeight(b.props.Margin)
+	if b.props.Direction == Row {
+		mainSize = layout.Width - borderWidth - spacingWidth(b.props.Padding) - spacingWidth(b.props.Margin)
+	}
+
+	trees := make([]*LayoutTree, len(b.children))
+	starts := make([]int, len(b.children))
+	position := 0
+	for i, size := range sizes {
+		if i > 0 {
+			position += childSpacing(b.props, b.children, i-1)
+		}
+		starts[i] = position
+		tree := &LayoutTree{Component: b.children[i], Layout: Layout{Width: size.Width, Height: size.Height}}
+		if b.props.Direction == Row {
+			tree.Layout.X = position
+			position += size.Width
+		} else {
+			tree.Layout.Y = position
+			position += size.Height
+		}
+		trees[i] = tree
+	}
+	justifyContent(trees, b.props, mainSize)
+
+	moved := 0
+	for i, tree := range trees {
+		offset := tree.Layout.X + tree.Layout.Y - starts[i]
+		leads[i] = max(0, offset-moved)
+		moved += leads[i]
+	}
+	return leads
+}
+
+// renderEmpty draws the border and padding of a box without children,
+// filling the space measureBox reserves inside the border with blanks. A box
+// with neither renders as "".
+func (b *box) renderEmpty(layout Layout) string {
+	borderWidth, borderHeight := borderSize(b.props)
+	if borderWidth == 0 && borderHeight == 0 && b.props.Padding == (Spacing{}) {
+		return ""
+	}
+	width := max(0, layout.Width-spacingWidth(b.props.Margin))
+	height := max(0, layout.Height-spacingHeight(b.props.Margin))
+	if width == 0 || height == 0 {
+		return ""
+	}
+
+	innerHeight := height - borderHeight
+	output := b.decorate(padBlock("", width-borderWidth, innerHeight), layout)
+	if innerHeight > 0 {
+		return output
+	}
+	// lipgloss draws a line for empty content; drop it so only the border remains.
+	_, borderTop := borderOffset(b.props)
+	lines := strings.Split(output, "\n")
+	lines = append(lines[:borderTop], lines[borderTop+1:]...)
+	return strings.Join(lines, "\n")
+}
+
+// decorate clips content to the box and draws its border, border title and
+// background around it.
+func (b *box) decorate(content string, layout Layout) string {
+	borderWidth, _ := borderSize(b.props)
+	if b.props.Overflow != OverflowVisible {
+		content = b.clip(content, layout)
+	}
+	if b.hasBorderTitle() {
+		// Leave room for the title between the top corners, up to the laid-out
+		// width; applyBorderTitle truncates titles that do not fit.
+		titleWidth := borderTitleWidth(b.props.BorderTitle)
+		if layout.Width > 0 {
+			titleWidth = min(titleWidth, layout.Width)
+		}
+		content = padBlock(content, titleWidth-borderWidth, 0)
 	}
 
 	style := lipgloss.NewStyle()
 
-	if b.props.Border != BorderNone {
+	if top, right, bottom, left := borderSides(b.props); top || right || bottom || left {
 		style = b.applyBorder(style)
 	}
 
-	if b.props.Background != "" {
+	if b.props.Background != "" && !noColorRender {
 		style = style.Background(lipgloss.Color(b.props.Background))
 	}
 
-	return style.Render(content)
+	output := style.Render(content)
+	if b.hasBorderTitle() {
+		output = b.applyBorderTitle(output)
+	}
+	return output
+}
+
+// hasBorderTitle reports whether a title is drawn in the top border.
+func (b *box) hasBorderTitle() bool {
+	return b.props.Border != BorderNone && b.props.BorderTitle != ""
+}
+
+// borderTitleWidth returns the narrowest bordered box that fits title: the
+// corners, a border rune on each side and a space around the title.
+func borderTitleWidth(title string) int {
+	return lipgloss.Width(title) + 6
+}
+
+// applyBorderTitle replaces the top border line of output with one that
+// embeds the title, such as "┌─ Title ────┐".
+func (b *box) applyBorderTitle(output string) string {
+	lines := strings.SplitN(output, "\n", 2)
+	width := lipgloss.Width(lines[0])
+	border := lipglossBorder(b.props.Border)
+
+	title := " " + b.props.BorderTitle + " "
+	fill := width - 4 - lipgloss.Width(title)
+	if fill < 0 {
+		title = " " + truncateLines(b.props.BorderTitle, max(0, width-6)) + " "
+		fill = max(0, width-4-lipgloss.Width(title))
+	}
+
+	var left int
+	switch b.props.BorderTitleAlign {
+	case TextAlignCenter:
+		left = fill / 2
+	case TextAlignRight:
+		left = fill
+	}
+	top := border.TopLeft + strings.Repeat(border.Top, left+1) + title +
+		strings.Repeat(border.Top, fill-left+1) + border.TopRight
+
+	if b.props.BorderColor != "" && !noColorRender {
+		top = lipgloss.NewStyle().Foreground(lipgloss.Color(b.props.BorderColor)).Render(top)
+	}
+	lines[0] = top
+	return strings.Join(lines, "\n")
+}
+
+// renderRow renders each child at the given size and places the results
+// side by side, line by line, after leads[i] blank columns. Children shorter
+// than the tallest one are padded with blank lines, and every line is padded
+// to its child's width.
+func (b *box) renderRow(layout Layout, sizes []Size, leads []int) string {
+	var content string
+	x := layout.X
+	for i, child := range b.children {
+		spacing := leads[i]
+		if i > 0 {
+			spacing += childSpacing(b.props, b.children, i-1)
+		}
+		x += spacing
+		size := sizes[i]
+		rendered := child.Render(Layout{X: x, Y: layout.Y, Width: size.Width, Height: size.Height})
+		block := padBlock(rendered, size.Width, size.Height)
+		x += size.Width
+
+		switch {
+		case i == 0 && spacing > 0:
+			content = lipgloss.JoinHorizontal(lipgloss.Top, strings.Repeat(" ", spacing), block)
+		case i == 0:
+			content = block
+		case spacing < 0:
+			height := max(VisualHeight(content), VisualHeight(block))
+			content = overlapColumns(padBlock(content, lipgloss.Width(content), height), padBlock(block, size.Width, height))
+		case spacing > 0:
+			content = lipgloss.JoinHorizontal(lipgloss.Top, content, strings.Repeat(" ", spacing), block)
+		default:
+			content = lipgloss.JoinHorizontal(lipgloss.Top, content, block)
+		}
+	}
+	return content
+}
+
+// padBlock pads rendered to at least height lines of at least width cells.
+func padBlock(rendered string, width, height int) string {
+	lines := strings.Split(rendered, "\n")
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	for i, line := range lines {
+		if w := lipgloss.Width(line); w < width {
+			lines[i] = line + strings.Repeat(" ", width-w)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// clip trims content to the inner area of the box, honoring ScrollOffset in scroll mode.
+func (b *box) clip(content string, layout Layout) string {
+	borderWidth, borderHeight := borderSize(b.props)
+	width := layout.Width - borderWidth - spacingWidth(b.props.Padding) - spacingWidth(b.props.Margin)
+	height := layout.Height - borderHeight - spacingHeight(b.props.Padding) - spacingHeight(b.props.Margin)
+
+	offset := 0
+	if b.props.Overflow == OverflowScroll {
+		offset = b.props.ScrollOffset
+	}
+
+	return clipLines(content, width, height, offset)
+}
+
+// clipLines keeps at most height lines starting at offset, each truncated to width cells.
+func clipLines(content string, width, height, offset int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	lines := strings.Split(content, "\n")
+	offset = max(0, min(offset, len(lines)))
+	lines = lines[offset:]
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+
+	return truncateLines(strings.Join(lines, "\n"), width)
+}
+
+// truncateLines cuts every line of content to at most width cells, keeping
+// ANSI escape sequences intact.
+func truncateLines(content string, width int) string {
+	lines := strings.Split(content, "\n")
+	truncate := lipgloss.NewStyle().MaxWidth(width)
+	for i, line := range lines {
+		if VisualWidth(line) > width {
+			lines[i] = truncate.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// lipglossBorder returns the lipgloss border runes for style, using the
+// single-line runes for BorderNone.
+func lipglossBorder(style BorderStyle) lipgloss.Border {
+	switch style {
+	case BorderDouble:
+		return lipgloss.DoubleBorder()
+	case BorderRounded:
+		return lipgloss.RoundedBorder()
+	default:
+		return lipgloss.NormalBorder()
+	}
 }
 
 func (b *box) applyBorder(style lipgloss.Style) lipgloss.Style {
@@ -93,9 +454,12 @@ func (b *box) applyBorder(style lipgloss.Style) lipgloss.Style {
 		style = style.Border(lipgloss.DoubleBorder())
 	case BorderRounded:
 		style = style.Border(lipgloss.RoundedBorder())
+	case BorderNone:
+		top, right, bottom, left := borderSides(b.props)
+		style = style.Border(lipgloss.NormalBorder(), top, right, bottom, left)
 	}
 
-	if b.props.BorderColor != "" {
+	if b.props.BorderColor != "" && !noColorRender {
 		style = style.BorderForeground(lipgloss.Color(b.props.BorderColor))
 	}
 
@@ -107,12 +471,71 @@ func (b *box) Children() []Component {
 	return b.children
 }
 
+// Extendable is an optional extension of Component.
+// Components that implement it return a copy of themselves with more children
+// appended, leaving the original unchanged. Box implements it.
+type Extendable interface {
+	With(children ...Component) Component
+}
+
+// With returns c with children appended after its existing children. When c
+// is not Extendable, it returns a column box holding c followed by children.
+//
+// Example:
+//
+//	base := Box(props, Text("title"))
+//	full := With(base, Text("extra line"))
+func With(c Component, children ...Component) Component {
+	if e, ok := c.(Extendable); ok {
+		return e.With(children...)
+	}
+	return Box(BoxProps{Direction: Column}, append([]Component{c}, children...)...)
+}
+
+// With returns a new box with the same props and the given children appended
+// after the existing ones. The original box is left unchanged.
+func (b *box) With(children ...Component) Component {
+	combined := make([]Component, 0, len(b.children)+len(children))
+	combined = append(combined, b.children...)
+	combined = append(combined, children...)
+	return Box(b.props, combined...)
+}
+
 // Key returns the unique identifier for this component.
 func (b *box) Key() string {
 	return b.props.Key
 }
 
+func (b *box) label() string {
+	return "box"
+}
+
 // Measure calculates the size requirements for this component.
 func (b *box) Measure(availableWidth, availableHeight int) Size {
 	return measureBox(b.props, b.children, availableWidth, availableHeight)
 }
+
+// measureIn measures the box inside a parent laid out in direction. A row
+// with an auto width and a child with FlexGrow spans the available width of
+// a column, so that its growing children have free space to share.
+func (b *box) measureIn(direction Direction, availableWidth, availableHeight int) Size {
+	size := b.Measure(availableWidth, availableHeight)
+	if direction != Column || b.props.Direction != Row || !hasFlexGrowChild(b.children) {
+		return size
+	}
+	if _, ok := b.props.Width.(dimensionAuto); !ok && b.props.Width != nil {
+		return size
+	}
+	size.Width = max(size.Width, availableWidth)
+	return applyConstraints(size, b.props.MinWidth, b.props.MinHeight, b.props.MaxWidth, b.props.MaxHeight)
+}
+
+// hasFlexGrowChild reports whether any of children is a box with FlexGrow.
+func hasFlexGrowChild(children []Component) bool {
+	for _, child := range children {
+		if b, ok := child.(*box); ok && b.props.FlexGrow > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -3,7 +3,10 @@ package runetui
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestBox_EmptyBox_CanBeCreated(t *testing.T) {
@@ -137,6 +140,29 @@ func TestBox_Render_EmptyBoxReturnsEmptyString(t *testing.T) {
 	}
 }
 
+func TestBox_Render_EmptyBoxWithBorderAndPadding_DrawsMeasuredFrame(t *testing.T) {
+	box := Box(BoxProps{Border: BorderSingle, Padding: SpacingAll(1)})
+	size := box.Measure(80, 24)
+
+	output := box.Render(Layout{Width: size.Width, Height: size.Height})
+
+	want := "┌──┐\n│  │\n│  │\n└──┘"
+	if output != want {
+		t.Errorf("Render() = %q, want %q", output, want)
+	}
+	AssertHeight(t, output, size.Height)
+}
+
+func TestBox_Render_EmptyBoxWithBorderOnly_DrawsBorderWithoutInnerLine(t *testing.T) {
+	box := Box(BoxProps{Border: BorderSingle})
+
+	output := box.Render(Layout{Width: 2, Height: 2})
+
+	if output != "┌┐\n└┘" {
+		t.Errorf("Render() = %q, want %q", output, "┌┐\n└┘")
+	}
+}
+
 func TestBox_Render_SingleChildRendersChildContent(t *testing.T) {
 	child := &mockComponent{key: "child", content: "Hello"}
 
@@ -386,6 +412,82 @@ func TestBox_DirectionVariations_ProducesValidOutput(t *testing.T) {
 	}
 }
 
+func TestBox_Render_BorderTitle_Golden(t *testing.T) {
+	borders := map[string]BorderStyle{"single": BorderSingle, "double": BorderDouble, "rounded": BorderRounded}
+	aligns := map[string]TextAlign{"left": TextAlignLeft, "center": TextAlignCenter, "right": TextAlignRight}
+
+	for borderName, border := range borders {
+		for alignName, align := range aligns {
+			name := "box_border_title_" + borderName + "_" + alignName
+			t.Run(name, func(t *testing.T) {
+				props := BoxProps{Border: border, BorderTitle: "Panel", BorderTitleAlign: align}
+				box := Box(props, &mockComponent{content: "content of the panel"})
+
+				compareWithGoldenBox(t, name, box.Render(Layout{Width: 22, Height: 3}))
+			})
+		}
+	}
+}
+
+func TestBox_Render_BorderTitle_WidensNarrowContent(t *testing.T) {
+	box := Box(BoxProps{Border: BorderSingle, BorderTitle: "Logs"}, &mockComponent{content: "a"})
+
+	got := box.Render(Layout{Width: 10, Height: 3})
+
+	expected := "┌─ Logs ─┐\n│a       │\n└────────┘"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestBox_Render_BorderTitle_FixedWidth_TruncatesTitle(t *testing.T) {
+	box := Box(BoxProps{Border: BorderSingle, BorderTitle: "A very long panel title", Width: DimensionFixed(10)}, Text("a"))
+	tree := NewLayoutEngine(80, 24).CalculateLayout(box)
+
+	got := StripANSI(renderTree(tree))
+
+	for i, line := range strings.Split(got, "\n") {
+		if w := lipgloss.Width(line); w != 10 {
+			t.Errorf("expected line %d to be 10 columns, got %d in %q", i, w, got)
+		}
+	}
+	if !strings.HasPrefix(got, "┌─ A ") {
+		t.Errorf("expected a truncated title, got %q", got)
+	}
+}
+
+func TestBox_Render_BorderTitle_UsesBorderColor(t *testing.T) {
+	box := Box(BoxProps{Border: BorderSingle, BorderTitle: "Logs", BorderColor: "#00FF00"}, &mockComponent{content: "a"})
+
+	top := strings.Split(box.Render(Layout{Width: 10, Height: 3}), "\n")[0]
+
+	if !strings.Contains(top, "38;2;0;255;0") || StripANSI(top) != "┌─ Logs ─┐" {
+		t.Errorf("expected a colored titled border, got %q", top)
+	}
+}
+
+func TestBox_Render_BorderTitle_IgnoredWithoutBorder(t *testing.T) {
+	box := Box(BoxProps{BorderTitle: "Logs"}, &mockComponent{content: "a"})
+
+	if got := box.Render(Layout{Width: 10, Height: 1}); got != "a" {
+		t.Errorf("expected no title without a border, got %q", got)
+	}
+}
+
+func TestBox_Measure_BorderTitle_SetsMinimumWidth(t *testing.T) {
+	child := &mockComponent{content: "a", width: 1, height: 1}
+
+	titled := Box(BoxProps{Border: BorderSingle, BorderTitle: "Dashboard"}, child).Measure(80, 24)
+	if titled.Width != 15 {
+		t.Errorf("expected width 15 to fit the title, got %d", titled.Width)
+	}
+
+	untitled := Box(BoxProps{BorderTitle: "Dashboard"}, child).Measure(80, 24)
+	if untitled.Width != 1 {
+		t.Errorf("expected the title ignored without a border, got %d", untitled.Width)
+	}
+}
+
 // Golden file helpers for behavioral testing
 
 func loadGoldenFileBox(t *testing.T, name string) string {
@@ -452,3 +554,355 @@ func (m *mockComponent) Key() string                 { return m.key }
 func (m *mockComponent) Measure(w, h int) Size {
 	return Size{Width: m.width, Height: m.height}
 }
+
+func TestBox_Render_OverflowVisible_KeepsAllLines(t *testing.T) {
+	component := Box(BoxProps{Direction: Column},
+		&mockComponent{content: "one"},
+		&mockComponent{content: "two"},
+		&mockComponent{content: "three"},
+	)
+
+	got := component.Render(Layout{Width: 3, Height: 2})
+
+	if got != "one\ntwo\nthree" {
+		t.Errorf("expected all lines, got %q", got)
+	}
+}
+
+func TestBox_Render_OverflowHidden_ClipsToLayout(t *testing.T) {
+	component := Box(BoxProps{Direction: Column, Overflow: OverflowHidden},
+		&mockComponent{content: "one"},
+		&mockComponent{content: "two"},
+		&mockComponent{content: "three"},
+	)
+
+	got := component.Render(Layout{Width: 2, Height: 2})
+
+	if got != "on\ntw" {
+		t.Errorf("expected clipped output, got %q", got)
+	}
+}
+
+func TestBox_Render_OverflowHiddenWithBorder_ClipsInsideBorder(t *testing.T) {
+	component := Box(BoxProps{Direction: Column, Overflow: OverflowHidden, Border: BorderSingle},
+		&mockComponent{content: "one"},
+		&mockComponent{content: "two"},
+		&mockComponent{content: "three"},
+	)
+
+	got := StripANSI(component.Render(Layout{Width: 4, Height: 3}))
+
+	expected := "┌──┐\n│on│\n└──┘"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestBox_Render_OverflowScroll_StartsAtScrollOffset(t *testing.T) {
+	component := Box(BoxProps{Direction: Column, Overflow: OverflowScroll, ScrollOffset: 1},
+		&mockComponent{content: "one"},
+		&mockComponent{content: "two"},
+		&mockComponent{content: "three"},
+	)
+
+	got := component.Render(Layout{Width: 5, Height: 2})
+
+	if got != "two\nthree" {
+		t.Errorf("expected lines two and three, got %q", got)
+	}
+}
+
+func TestBox_Render_OverflowScroll_OffsetPastEnd_RendersEmpty(t *testing.T) {
+	component := Box(BoxProps{Direction: Column, Overflow: OverflowScroll, ScrollOffset: 10},
+		&mockComponent{content: "one"},
+	)
+
+	got := component.Render(Layout{Width: 5, Height: 2})
+
+	if got != "" {
+		t.Errorf("expected empty output, got %q", got)
+	}
+}
+
+func TestBox_Render_OverflowScroll_NegativeOffset_StartsAtTop(t *testing.T) {
+	component := Box(BoxProps{Direction: Column, Overflow: OverflowScroll, ScrollOffset: -3},
+		&mockComponent{content: "one"},
+		&mockComponent{content: "two"},
+	)
+
+	got := component.Render(Layout{Width: 3, Height: 1})
+
+	if got != "one" {
+		t.Errorf("expected first line, got %q", got)
+	}
+}
+
+func TestClipLines_WithANSI_TruncatesVisibleWidth(t *testing.T) {
+	styled := "\x1b[1mHello\x1b[0m"
+
+	got := clipLines(styled, 3, 1, 0)
+
+	if StripANSI(got) != "Hel" {
+		t.Errorf("expected visible 'Hel', got %q", StripANSI(got))
+	}
+}
+
+func TestClipLines_ZeroSize_ReturnsEmpty(t *testing.T) {
+	if got := clipLines("Hello", 0, 1, 0); got != "" {
+		t.Errorf("expected empty output, got %q", got)
+	}
+}
+
+func TestBox_NilChildren_AreDropped(t *testing.T) {
+	b := Box(BoxProps{}, nil, Text("a"), nil, Text("b"), nil)
+
+	children := b.Children()
+
+	if len(children) != 2 {
+		t.Fatalf("expected 2 children, got %d", len(children))
+	}
+	if children[0].(*text).content != "a" || children[1].(*text).content != "b" {
+		t.Errorf("expected children a and b in order, got %v", children)
+	}
+}
+
+func TestBox_NilChildren_DoesNotModifyCallerSlice(t *testing.T) {
+	children := []Component{Text("a"), nil, Text("b")}
+
+	Box(BoxProps{}, children...)
+
+	if children[1] != nil || children[2] == nil {
+		t.Error("expected caller slice to be unchanged")
+	}
+}
+
+func TestBox_OnlyNilChildren_HasNoChildren(t *testing.T) {
+	b := Box(BoxProps{}, nil, nil)
+
+	if len(b.Children()) != 0 {
+		t.Errorf("expected no children, got %d", len(b.Children()))
+	}
+}
+
+func TestBox_With_AppendsChildrenToNewBox(t *testing.T) {
+	base := Box(BoxProps{Key: "base"}, Text("a")).(*box)
+
+	extended := base.With(Text("b"), nil, Text("c"))
+
+	if len(base.Children()) != 1 {
+		t.Errorf("expected original box to keep 1 child, got %d", len(base.Children()))
+	}
+	if len(extended.Children()) != 3 {
+		t.Fatalf("expected 3 children, got %d", len(extended.Children()))
+	}
+	if extended.Key() != "base" || extended.(*box) == base {
+		t.Error("expected a new box with the same props")
+	}
+}
+
+func TestBox_With_DoesNotShareBackingArray(t *testing.T) {
+	children := make([]Component, 1, 4)
+	children[0] = Text("a")
+	base := Box(BoxProps{}, children...).(*box)
+
+	first := base.With(Text("b"))
+	second := base.With(Text("c"))
+
+	if first.Children()[1].(*text).content != "b" || second.Children()[1].(*text).content != "c" {
+		t.Error("expected each With call to produce independent children")
+	}
+}
+
+func TestWith_Box_AppendsChildren(t *testing.T) {
+	base := Box(BoxProps{Key: "base"}, Text("a"))
+
+	extended := With(base, Text("b"))
+
+	if len(extended.Children()) != 2 || extended.Key() != "base" {
+		t.Errorf("expected box 'base' with 2 children, got %q with %d", extended.Key(), len(extended.Children()))
+	}
+	if len(base.Children()) != 1 {
+		t.Errorf("expected original box to keep 1 child, got %d", len(base.Children()))
+	}
+}
+
+func TestWith_NotExtendable_WrapsInColumn(t *testing.T) {
+	extended := With(Text("a"), Text("b"))
+
+	output := extended.Render(Layout{Width: 1, Height: 2})
+
+	if StripANSI(output) != "a\nb" {
+		t.Errorf("expected %q, got %q", "a\nb", StripANSI(output))
+	}
+}
+
+func TestSpacingBox_SetsPaddingInCSSOrder(t *testing.T) {
+	props := SpacingBox(1, 2, 3, 4)
+
+	expected := Spacing{Top: 1, Right: 2, Bottom: 3, Left: 4}
+	if props.Padding != expected {
+		t.Errorf("expected padding %+v, got %+v", expected, props.Padding)
+	}
+	if props.Direction != Column || props.Border != BorderNone {
+		t.Error("expected other props to keep their zero values")
+	}
+}
+
+func TestPaddedBox_WrapsChildrenWithPadding(t *testing.T) {
+	b := PaddedBox(SpacingAll(1), Text("a"), nil, Text("b")).(*box)
+
+	if b.props.Padding != SpacingAll(1) || len(b.children) != 2 {
+		t.Errorf("unexpected box props %+v with %d children", b.props, len(b.children))
+	}
+	size := b.Measure(80, 24)
+	if size.Width != 3 || size.Height != 4 {
+		t.Errorf("expected 3x4 including padding, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestBox_Row_ZipsMultiLineChildren(t *testing.T) {
+	b := Box(BoxProps{Direction: Row},
+		Text("one two three", TextProps{Wrap: WrapWord}),
+		Text("|x"),
+	)
+
+	output := StripANSI(b.Render(Layout{Width: 5, Height: 3}))
+
+	expected := "one  |x\ntwo    \nthree  "
+	if output != expected {
+		t.Errorf("expected lines zipped side by side:\n%q\ngot:\n%q", expected, output)
+	}
+}
+
+func TestBox_Row_PadsShorterFirstChildAndHonorsGap(t *testing.T) {
+	b := Box(BoxProps{Direction: Row, Gap: 2},
+		Text("ab"),
+		Box(BoxProps{}, Text("1"), Text("2")),
+	)
+
+	output := StripANSI(b.Render(Layout{Width: 20, Height: 2}))
+
+	expected := "ab  1\n    2"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestBox_GapXAndGapY_ApplyToTheirAxis(t *testing.T) {
+	cell := func(s string) Component { return Text(s) }
+	row := func(a, b string) Component {
+		return Box(BoxProps{Direction: Row, GapX: 2, GapY: 1}, cell(a), cell(b))
+	}
+	grid := Box(BoxProps{Direction: Column, GapX: 2, GapY: 1}, row("a", "b"), row("c", "d"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(grid)
+
+	if tree.Layout.Width != 4 || tree.Layout.Height != 3 {
+		t.Errorf("expected 4x3 grid, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+	d := tree.Children[1].Children[1].Layout
+	if d.X != 3 || d.Y != 2 {
+		t.Errorf("expected d at 3,2, got %d,%d", d.X, d.Y)
+	}
+}
+
+func TestBoxProps_MainGap_FallsBackToGap(t *testing.T) {
+	tests := []struct {
+		props    BoxProps
+		expected int
+	}{
+		{BoxProps{Direction: Row, Gap: 1}, 1},
+		{BoxProps{Direction: Row, Gap: 1, GapX: 3}, 3},
+		{BoxProps{Direction: Row, Gap: 1, GapY: 3}, 1},
+		{BoxProps{Direction: Column, Gap: 1, GapY: 2}, 2},
+		{BoxProps{Direction: Column, GapX: 2}, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.props.mainGap(); got != tt.expected {
+			t.Errorf("%+v: expected gap %d, got %d", tt.props, tt.expected, got)
+		}
+	}
+}
+
+func TestBox_Render_DimensionFillRowChild_FillsRemainingWidth(t *testing.T) {
+	root := Box(BoxProps{Direction: Row, Width: DimensionFixed(24)},
+		Box(BoxProps{Width: DimensionFixed(8), Border: BorderSingle}, Text("Side")),
+		Box(BoxProps{Width: DimensionFill(), Border: BorderSingle}, Text("Main")),
+	)
+
+	lines := strings.Split(StripANSI(root.Render(Layout{Width: 24, Height: 3})), "\n")
+
+	if lines[0] != "┌──────┐┌──────────────┐" {
+		t.Errorf("expected the fill box to reach the right edge, got %q", lines[0])
+	}
+}
+
+func TestBox_Render_DimensionFillColumnChild_FillsRemainingHeight(t *testing.T) {
+	root := Box(BoxProps{Direction: Column, Height: DimensionFixed(6)},
+		Text("Header"),
+		Box(BoxProps{Height: DimensionFill()}, Text("Body")),
+		Text("Footer"),
+	)
+
+	lines := strings.Split(StripANSI(root.Render(Layout{Width: 10, Height: 6})), "\n")
+
+	if len(lines) != 6 || strings.TrimSpace(lines[5]) != "Footer" {
+		t.Errorf("expected the footer on the last of 6 lines, got %q", lines)
+	}
+}
+
+func TestBox_BorderTopOnly_RendersLineAboveContent(t *testing.T) {
+	tree := NewLayoutEngine(30, 5).CalculateLayout(Box(BoxProps{BorderTop: true}, Text("Hi")))
+
+	output := StripANSI(renderTree(tree))
+
+	if output != "──\nHi" {
+		t.Errorf("expected %q, got %q", "──\nHi", output)
+	}
+	if tree.Layout.Width != 2 || tree.Layout.Height != 2 {
+		t.Errorf("expected size 2x2, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+}
+
+func TestBox_BorderLeftAndRight_RendersSidesOnly(t *testing.T) {
+	tree := NewLayoutEngine(30, 5).CalculateLayout(Box(BoxProps{BorderLeft: true, BorderRight: true}, Text("Hi")))
+
+	output := StripANSI(renderTree(tree))
+
+	if output != "│Hi│" {
+		t.Errorf("expected %q, got %q", "│Hi│", output)
+	}
+	if tree.Layout.Width != 4 || tree.Layout.Height != 1 {
+		t.Errorf("expected size 4x1, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+}
+
+func TestBox_BorderBottomOnly_RendersLineBelowContent(t *testing.T) {
+	tree := NewLayoutEngine(30, 5).CalculateLayout(Box(BoxProps{BorderBottom: true}, Text("Hi")))
+
+	output := StripANSI(renderTree(tree))
+
+	if output != "Hi\n──" {
+		t.Errorf("expected %q, got %q", "Hi\n──", output)
+	}
+}
+
+func TestBox_BorderLeft_OffsetsChildLayout(t *testing.T) {
+	tree := NewLayoutEngine(30, 5).CalculateLayout(Box(BoxProps{BorderLeft: true, BorderBottom: true}, Text("Hi")))
+
+	child := tree.Children[0].Layout
+	if child.X != 1 || child.Y != 0 {
+		t.Errorf("expected child at (1, 0), got (%d, %d)", child.X, child.Y)
+	}
+}
+
+func TestBox_BorderStyle_IgnoresIndividualSides(t *testing.T) {
+	tree := NewLayoutEngine(30, 5).CalculateLayout(Box(BoxProps{Border: BorderSingle, BorderTop: true}, Text("Hi")))
+
+	output := StripANSI(renderTree(tree))
+
+	if output != "┌──┐\n│Hi│\n└──┘" {
+		t.Errorf("expected a full border, got %q", output)
+	}
+}
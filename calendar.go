@@ -0,0 +1,165 @@
+package runetui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// calendarWidth is seven two-character day columns separated by spaces.
+	calendarWidth = 7*3 - 1
+	// calendarWeeks is the number of week rows, enough for any month.
+	calendarWeeks = 6
+	// calendarHeaderHeight covers the month/year title and the weekday names.
+	calendarHeaderHeight = 2
+)
+
+// calendarNow returns the current time; tests replace it to fix "today".
+var calendarNow = time.Now
+
+// CalendarProps defines properties for the Calendar component.
+// Month is 1-12; a zero Year or Month shows the current month. Days in
+// HighlightedDays are underlined on top of DayStyle.
+type CalendarProps struct {
+	Year            int
+	Month           int
+	SelectedDay     int
+	HighlightedDays []int
+	DayStyle        TextProps
+	TodayStyle      TextProps
+	SelectedStyle   TextProps
+	HeaderStyle     TextProps
+	Key             string
+}
+
+func (CalendarProps) isProps() {}
+
+// PrevMonthMsg is dispatched when the user navigates to the previous month.
+type PrevMonthMsg struct{}
+
+// NextMonthMsg is dispatched when the user navigates to the next month.
+type NextMonthMsg struct{}
+
+// DaySelectMsg is dispatched when the user selects a day.
+type DaySelectMsg struct {
+	Date time.Time
+}
+
+// calendar is the private implementation of the Calendar component.
+type calendar struct {
+	props CalendarProps
+}
+
+// Calendar creates a month view: a "Month Year" header, the weekday names
+// starting on Sunday, and a 7-column grid of day numbers. The selected day,
+// today and highlighted days are styled with their respective props.
+// Use HandleCalendarKey in the UpdateFunc for keyboard navigation.
+func Calendar(props CalendarProps) Component {
+	if props.Year == 0 || props.Month == 0 {
+		now := calendarNow()
+		props.Year, props.Month = now.Year(), int(now.Month())
+	}
+	if props.SelectedStyle == (TextProps{}) {
+		props.SelectedStyle = TextProps{Bold: true, Underline: true}
+	}
+	if props.TodayStyle == (TextProps{}) {
+		props.TodayStyle = TextProps{Bold: true}
+	}
+	return &calendar{props: props}
+}
+
+// HandleCalendarKey returns the command for a key press on the calendar:
+// pgup or "<" dispatches PrevMonthMsg, pgdown or ">" dispatches NextMonthMsg,
+// and enter dispatches DaySelectMsg for the selected day. It returns nil for
+// other messages and when no day is selected.
+func HandleCalendarKey(props CalendarProps, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "pgup", "<":
+		return func() tea.Msg { return PrevMonthMsg{} }
+	case "pgdown", ">":
+		return func() tea.Msg { return NextMonthMsg{} }
+	case "enter":
+		if props.SelectedDay < 1 || props.SelectedDay > daysIn(props.Year, props.Month) {
+			return nil
+		}
+		date := time.Date(props.Year, time.Month(props.Month), props.SelectedDay, 0, 0, 0, 0, time.Local)
+		return func() tea.Msg { return DaySelectMsg{Date: date} }
+	}
+	return nil
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Render generates the header, weekday names and six week rows.
+func (c *calendar) Render(layout Layout) string {
+	first := time.Date(c.props.Year, time.Month(c.props.Month), 1, 0, 0, 0, 0, time.UTC)
+	title := fmt.Sprintf("%s %d", first.Month(), first.Year())
+
+	lines := []string{
+		Text(title, c.props.HeaderStyle).Render(Layout{Width: calendarWidth}),
+		"Su Mo Tu We Th Fr Sa",
+	}
+
+	cells := make([]string, 0, calendarWeeks*7)
+	for i := 0; i < int(first.Weekday()); i++ {
+		cells = append(cells, "  ")
+	}
+	for day := 1; day <= daysIn(c.props.Year, c.props.Month); day++ {
+		cells = append(cells, Text(fmt.Sprintf("%2d", day), c.dayStyle(day)).Render(Layout{Width: 2}))
+	}
+	for len(cells) < calendarWeeks*7 {
+		cells = append(cells, "  ")
+	}
+	for week := 0; week < calendarWeeks; week++ {
+		lines = append(lines, strings.Join(cells[week*7:week*7+7], " "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// dayStyle picks the text style for day, preferring selected over today.
+func (c *calendar) dayStyle(day int) TextProps {
+	if day == c.props.SelectedDay {
+		return c.props.SelectedStyle
+	}
+	now := calendarNow()
+	if c.props.Year == now.Year() && c.props.Month == int(now.Month()) && day == now.Day() {
+		return c.props.TodayStyle
+	}
+	style := c.props.DayStyle
+	for _, highlighted := range c.props.HighlightedDays {
+		if highlighted == day {
+			style.Underline = true
+		}
+	}
+	return style
+}
+
+// Children returns an empty slice since calendars have no children.
+func (c *calendar) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (c *calendar) Key() string {
+	return c.props.Key
+}
+
+func (c *calendar) label() string {
+	return "calendar"
+}
+
+// Measure returns the 7-column grid width and six week rows plus the header.
+func (c *calendar) Measure(availableWidth, availableHeight int) Size {
+	return Size{Width: calendarWidth, Height: calendarHeaderHeight + calendarWeeks}
+}
@@ -0,0 +1,134 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func fixCalendarNow(t *testing.T, now time.Time) {
+	t.Helper()
+	original := calendarNow
+	calendarNow = func() time.Time { return now }
+	t.Cleanup(func() { calendarNow = original })
+}
+
+func TestCalendar_Render_ShowsHeaderAndGrid(t *testing.T) {
+	fixCalendarNow(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	output := StripANSI(Calendar(CalendarProps{Year: 2026, Month: 2}).Render(Layout{}))
+
+	lines := strings.Split(output, "\n")
+	if len(lines) != 8 {
+		t.Fatalf("expected 8 lines, got %d: %q", len(lines), output)
+	}
+	if strings.TrimSpace(lines[0]) != "February 2026" || lines[1] != "Su Mo Tu We Th Fr Sa" {
+		t.Errorf("unexpected header %q / %q", lines[0], lines[1])
+	}
+	// February 1, 2026 is a Sunday.
+	if lines[2] != " 1  2  3  4  5  6  7" || lines[6] != strings.Repeat(" ", 20) {
+		t.Errorf("unexpected grid rows %q / %q", lines[2], lines[6])
+	}
+}
+
+func TestCalendar_Render_OffsetsFirstWeekday(t *testing.T) {
+	fixCalendarNow(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	output := StripANSI(Calendar(CalendarProps{Year: 2026, Month: 10}).Render(Layout{}))
+
+	// October 1, 2026 is a Thursday.
+	lines := strings.Split(output, "\n")
+	if lines[2] != "             1  2  3" {
+		t.Errorf("unexpected first week %q", lines[2])
+	}
+	if !strings.HasPrefix(lines[6], "25 26 27 28 29 30 31") {
+		t.Errorf("unexpected last week %q", lines[6])
+	}
+}
+
+func TestCalendar_DayStyle_PrefersSelectedThenToday(t *testing.T) {
+	fixCalendarNow(t, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	c := Calendar(CalendarProps{
+		Year: 2026, Month: 10, SelectedDay: 3, HighlightedDays: []int{20},
+		SelectedStyle: TextProps{Color: "#FF0000"},
+		TodayStyle:    TextProps{Color: "#00FF00"},
+	}).(*calendar)
+
+	if c.dayStyle(3).Color != "#FF0000" {
+		t.Error("expected selected style for day 3")
+	}
+	if c.dayStyle(16).Color != "#00FF00" {
+		t.Error("expected today style for day 16")
+	}
+	if !c.dayStyle(20).Underline || c.dayStyle(21).Underline {
+		t.Error("expected only highlighted days to be underlined")
+	}
+}
+
+func TestCalendar_ZeroMonth_UsesCurrentMonth(t *testing.T) {
+	fixCalendarNow(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+
+	c := Calendar(CalendarProps{}).(*calendar)
+
+	if c.props.Year != 2026 || c.props.Month != 10 {
+		t.Errorf("expected October 2026, got %d-%d", c.props.Year, c.props.Month)
+	}
+}
+
+func TestCalendar_Measure_ReturnsGridPlusHeader(t *testing.T) {
+	c := Calendar(CalendarProps{Year: 2026, Month: 1, Key: "cal"})
+
+	size := c.Measure(80, 24)
+
+	if size.Width != 20 || size.Height != 8 {
+		t.Errorf("expected 20x8, got %dx%d", size.Width, size.Height)
+	}
+	if c.Key() != "cal" || len(c.Children()) != 0 {
+		t.Error("unexpected key or children")
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	tests := []struct{ year, month, days int }{{2026, 2, 28}, {2024, 2, 29}, {2026, 12, 31}, {2026, 4, 30}}
+	for _, tt := range tests {
+		if got := daysIn(tt.year, tt.month); got != tt.days {
+			t.Errorf("daysIn(%d, %d) = %d, want %d", tt.year, tt.month, got, tt.days)
+		}
+	}
+}
+
+func TestHandleCalendarKey_NavigatesMonths(t *testing.T) {
+	props := CalendarProps{Year: 2026, Month: 10}
+
+	if msg := HandleCalendarKey(props, tea.KeyMsg{Type: tea.KeyPgUp})(); msg != (PrevMonthMsg{}) {
+		t.Errorf("expected PrevMonthMsg, got %#v", msg)
+	}
+	if msg := HandleCalendarKey(props, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'>'}})(); msg != (NextMonthMsg{}) {
+		t.Errorf("expected NextMonthMsg, got %#v", msg)
+	}
+}
+
+func TestHandleCalendarKey_Enter_SelectsDate(t *testing.T) {
+	cmd := HandleCalendarKey(CalendarProps{Year: 2026, Month: 10, SelectedDay: 16}, tea.KeyMsg{Type: tea.KeyEnter})
+
+	msg, ok := cmd().(DaySelectMsg)
+	if !ok || msg.Date.Year() != 2026 || msg.Date.Month() != time.October || msg.Date.Day() != 16 {
+		t.Errorf("expected DaySelectMsg for 2026-10-16, got %#v", cmd())
+	}
+}
+
+func TestHandleCalendarKey_InvalidSelectionOrOtherMessages_ReturnNil(t *testing.T) {
+	props := CalendarProps{Year: 2026, Month: 2, SelectedDay: 30}
+
+	if cmd := HandleCalendarKey(props, tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("expected nil for a day outside the month")
+	}
+	if cmd := HandleCalendarKey(props, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}}); cmd != nil {
+		t.Error("expected nil for other keys")
+	}
+	if cmd := HandleCalendarKey(props, tea.WindowSizeMsg{}); cmd != nil {
+		t.Error("expected nil for non-key messages")
+	}
+}
@@ -0,0 +1,47 @@
+package runetui
+
+// Cloneable is an optional extension of Component.
+// Components that implement it can produce an independent copy of themselves,
+// including copies of their children.
+type Cloneable interface {
+	Clone() Component
+}
+
+// CloneTree returns a deep copy of the component tree rooted at root.
+// Components that do not implement Cloneable are shared with the original.
+func CloneTree(root Component) Component {
+	if c, ok := root.(Cloneable); ok {
+		return c.Clone()
+	}
+	return root
+}
+
+// Clone returns a copy of the box with cloned children. Render memoization
+// state is not copied.
+func (b *box) Clone() Component {
+	children := make([]Component, len(b.children))
+	for i, child := range b.children {
+		children[i] = CloneTree(child)
+	}
+	return &box{
+		props:    b.props,
+		children: children,
+	}
+}
+
+// Clone returns a copy of the text. Render memoization state is not copied.
+func (t *text) Clone() Component {
+	return &text{
+		content: t.content,
+		props:   t.props,
+	}
+}
+
+// Clone returns a copy of the static component. The copy shares itemsFunc,
+// which is called on every render, so its items are produced fresh anyway.
+func (s *static) Clone() Component {
+	return &static{
+		props:     s.props,
+		itemsFunc: s.itemsFunc,
+	}
+}
@@ -0,0 +1,63 @@
+package runetui
+
+import "testing"
+
+func TestCloneTree_Box_CopiesNestedChildren(t *testing.T) {
+	original := Box(BoxProps{Key: "root"}, Box(BoxProps{Key: "inner"}, Text("leaf")), Text("sibling"))
+
+	clone := CloneTree(original).(*box)
+
+	if clone == original {
+		t.Fatal("expected a new box")
+	}
+	inner := clone.children[0].(*box)
+	if inner == original.(*box).children[0] || inner.children[0] == original.(*box).children[0].(*box).children[0] {
+		t.Error("expected nested children to be cloned")
+	}
+	if inner.props.Key != "inner" || inner.children[0].(*text).content != "leaf" {
+		t.Error("expected clone to keep props and content")
+	}
+}
+
+func TestCloneTree_ModifyingClone_LeavesOriginalUnchanged(t *testing.T) {
+	original := Box(BoxProps{Key: "root", Gap: 1}, Text("hello", TextProps{Bold: true})).(*box)
+
+	clone := CloneTree(original).(*box)
+	clone.props.Gap = 5
+	clone.children[0].(*text).content = "changed"
+	clone.children[0].(*text).props.Bold = false
+	clone.children = append(clone.children, Text("extra"))
+
+	if original.props.Gap != 1 || len(original.children) != 1 {
+		t.Error("expected original box props and children to be unchanged")
+	}
+	leaf := original.children[0].(*text)
+	if leaf.content != "hello" || !leaf.props.Bold {
+		t.Error("expected original text to be unchanged")
+	}
+}
+
+func TestCloneTree_Static_CopiesPropsAndSharesItems(t *testing.T) {
+	original := Static(StaticProps{Key: "log"}, func() []Component { return []Component{Text("line")} }).(*static)
+
+	clone := CloneTree(original).(*static)
+	clone.props.Key = "other"
+
+	if clone == original || original.props.Key != "log" {
+		t.Error("expected an independent static copy")
+	}
+	if len(clone.itemsFunc()) != 1 {
+		t.Error("expected clone to share itemsFunc")
+	}
+}
+
+func TestCloneTree_NonCloneable_ReturnsOriginal(t *testing.T) {
+	component := testComponent{key: "plain"}
+
+	if clone := CloneTree(component); clone.Key() != "plain" {
+		t.Errorf("expected original component, got %v", clone)
+	}
+	if CloneTree(nil) != nil {
+		t.Error("expected nil to stay nil")
+	}
+}
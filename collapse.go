@@ -0,0 +1,246 @@
+package runetui
+
+import "strings"
+
+// Arms of a box-drawing character: the directions its lines leave the cell.
+const (
+	armUp = 1 << iota
+	armDown
+	armLeft
+	armRight
+)
+
+// borderFamily groups box-drawing characters that can be joined together.
+type borderFamily int
+
+const (
+	familySingle borderFamily = iota
+	familyDouble
+)
+
+// borderRune describes a box-drawing character by family and arms.
+type borderRune struct {
+	family borderFamily
+	arms   int
+}
+
+// borderRunes lists the characters used by the border styles. Rounded corners
+// join as single lines.
+var borderRunes = map[rune]borderRune{
+	'─': {familySingle, armLeft | armRight},
+	'│': {familySingle, armUp | armDown},
+	'┌': {familySingle, armDown | armRight},
+	'┐': {familySingle, armDown | armLeft},
+	'└': {familySingle, armUp | armRight},
+	'┘': {familySingle, armUp | armLeft},
+	'╭': {familySingle, armDown | armRight},
+	'╮': {familySingle, armDown | armLeft},
+	'╰': {familySingle, armUp | armRight},
+	'╯': {familySingle, armUp | armLeft},
+	'├': {familySingle, armUp | armDown | armRight},
+	'┤': {familySingle, armUp | armDown | armLeft},
+	'┬': {familySingle, armDown | armLeft | armRight},
+	'┴': {familySingle, armUp | armLeft | armRight},
+	'┼': {familySingle, armUp | armDown | armLeft | armRight},
+	'═': {familyDouble, armLeft | armRight},
+	'║': {familyDouble, armUp | armDown},
+	'╔': {familyDouble, armDown | armRight},
+	'╗': {familyDouble, armDown | armLeft},
+	'╚': {familyDouble, armUp | armRight},
+	'╝': {familyDouble, armUp | armLeft},
+	'╠': {familyDouble, armUp | armDown | armRight},
+	'╣': {familyDouble, armUp | armDown | armLeft},
+	'╦': {familyDouble, armDown | armLeft | armRight},
+	'╩': {familyDouble, armUp | armLeft | armRight},
+	'╬': {familyDouble, armUp | armDown | armLeft | armRight},
+}
+
+// junctions maps a family and a set of arms back to a character.
+var junctions = func() map[borderRune]rune {
+	m := make(map[borderRune]rune)
+	for r, br := range borderRunes {
+		if _, taken := m[br]; !taken && !strings.ContainsRune("╭╮╰╯", r) {
+			m[br] = r
+		}
+	}
+	return m
+}()
+
+// collapses reports whether the shared edge between two adjacent children is
+// drawn once: the parent asks for it and both children are bordered boxes.
+func collapses(props BoxProps, a, b Component) bool {
+	return props.CollapseAdjacentBorders && bordered(a) && bordered(b)
+}
+
+// bordered reports whether c is a box with a border.
+func bordered(c Component) bool {
+	b, ok := c.(*box)
+	return ok && b.props.Border != BorderNone
+}
+
+// childSpacing returns the main-axis space between children i and i+1: the
+// box's gap, or -1 when their borders collapse into one line.
+func childSpacing(props BoxProps, children []Component, i int) int {
+	if collapses(props, children[i], children[i+1]) {
+		return -1
+	}
+	return max(0, props.mainGap())
+}
+
+// mergeBorderRunes joins two characters drawn in the same cell. Lines of the
+// same family are combined into a junction such as '├'; otherwise the
+// non-blank character wins, preferring b.
+func mergeBorderRunes(a, b rune) rune {
+	if b == ' ' {
+		return a
+	}
+	ba, okA := borderRunes[a]
+	bb, okB := borderRunes[b]
+	if !okA || !okB || ba.family != bb.family {
+		return b
+	}
+	if r, ok := junctions[borderRune{ba.family, ba.arms | bb.arms}]; ok {
+		return r
+	}
+	return b
+}
+
+// mergeBorderLines overlays two border lines, such as the bottom border of
+// one box and the top border of the box below it. Each merged cell keeps the
+// colors of the line whose character is drawn there, preferring lower.
+func mergeBorderLines(upper, lower string) string {
+	a := styledCells(upper)
+	b := styledCells(lower)
+	merged := make([]styledCell, max(len(a), len(b)))
+	for i := range merged {
+		ca, cb := styledCell{r: ' '}, styledCell{r: ' '}
+		if i < len(a) {
+			ca = a[i]
+		}
+		if i < len(b) {
+			cb = b[i]
+		}
+		merged[i] = styledCell{r: mergeBorderRunes(ca.r, cb.r), style: cb.style}
+		if cb.r == ' ' {
+			merged[i].style = ca.style
+		}
+	}
+	return joinStyledCells(merged)
+}
+
+// styledCell is a visible character and the escape sequences in effect for it.
+type styledCell struct {
+	r     rune
+	style string
+}
+
+// styledCells splits line into its visible characters, each with the escape
+// sequences set since the last reset.
+func styledCells(line string) []styledCell {
+	var cells []styledCell
+	style := ""
+	pos := 0
+	for _, loc := range append(ansiPattern.FindAllStringIndex(line, -1), []int{len(line), len(line)}) {
+		for _, r := range line[pos:loc[0]] {
+			cells = append(cells, styledCell{r: r, style: style})
+		}
+		if seq := line[loc[0]:loc[1]]; seq == "\x1b[0m" || seq == "\x1b[m" {
+			style = ""
+		} else {
+			style += seq
+		}
+		pos = loc[1]
+	}
+	return cells
+}
+
+// joinStyledCells renders cells as a line, switching escape sequences only
+// where the style changes and resetting at the end.
+func joinStyledCells(cells []styledCell) string {
+	var sb strings.Builder
+	style := ""
+	for _, c := range cells {
+		if c.style != style {
+			if style != "" {
+				sb.WriteString("\x1b[0m")
+			}
+			sb.WriteString(c.style)
+			style = c.style
+		}
+		sb.WriteRune(c.r)
+	}
+	if style != "" {
+		sb.WriteString("\x1b[0m")
+	}
+	return sb.String()
+}
+
+// joinColumn stacks rendered children one per line, merging the touching
+// border lines of children whose borders collapse.
+func joinColumn(props BoxProps, children []Component, parts []string) string {
+	var lines []string
+	for i, part := range parts {
+		partLines := strings.Split(part, "\n")
+		if i > 0 && len(lines) > 0 && collapses(props, children[i-1], children[i]) {
+			lines[len(lines)-1] = mergeBorderLines(lines[len(lines)-1], partLines[0])
+			partLines = partLines[1:]
+		}
+		lines = append(lines, partLines...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// overlapColumns joins two blocks of equal height side by side, drawing the
+// right edge of left and the left edge of right in a single shared column.
+func overlapColumns(left, right string) string {
+	leftLines := strings.Split(left, "\n")
+	rightLines := strings.Split(right, "\n")
+	for i := range leftLines {
+		if i >= len(rightLines) {
+			break
+		}
+		before, a, after := splitLastRune(leftLines[i])
+		b, rest := splitFirstRune(rightLines[i])
+		leftLines[i] = before + string(mergeBorderRunes(a, b)) + after + rest
+	}
+	return strings.Join(leftLines, "\n")
+}
+
+// splitLastRune returns line without its last visible character, that
+// character, and the escape sequences that follow it.
+func splitLastRune(line string) (string, rune, string) {
+	trailing := ""
+	for {
+		locs := ansiPattern.FindAllStringIndex(line, -1)
+		if len(locs) == 0 || locs[len(locs)-1][1] != len(line) {
+			break
+		}
+		start := locs[len(locs)-1][0]
+		trailing = line[start:] + trailing
+		line = line[:start]
+	}
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return line, ' ', trailing
+	}
+	return string(runes[:len(runes)-1]), runes[len(runes)-1], trailing
+}
+
+// splitFirstRune returns the first visible character of line and the rest of
+// the line with that character removed and any escape sequences before it kept.
+func splitFirstRune(line string) (rune, string) {
+	leading := ""
+	for {
+		loc := ansiPattern.FindStringIndex(line)
+		if loc == nil || loc[0] != 0 {
+			break
+		}
+		leading += line[:loc[1]]
+		line = line[loc[1]:]
+	}
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return ' ', leading
+	}
+	return runes[0], leading + string(runes[1:])
+}
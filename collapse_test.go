@@ -0,0 +1,114 @@
+package runetui
+
+import "testing"
+
+func borderedText(content string, border BorderStyle) Component {
+	return Box(BoxProps{Border: border}, Text(content))
+}
+
+func TestCollapse_Column_SharesHorizontalEdge(t *testing.T) {
+	root := Box(BoxProps{Direction: Column, CollapseAdjacentBorders: true},
+		borderedText("ab", BorderSingle),
+		borderedText("cd", BorderSingle),
+	)
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+	output := StripANSI(root.Render(tree.Layout))
+
+	expected := "┌──┐\n│ab│\n├──┤\n│cd│\n└──┘"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+	if tree.Layout.Height != 5 || tree.Children[1].Layout.Y != 2 {
+		t.Errorf("expected height 5 with second box at y=2, got height %d and y=%d", tree.Layout.Height, tree.Children[1].Layout.Y)
+	}
+}
+
+func TestMergeBorderLines_DifferentWidths_UsesTJunctions(t *testing.T) {
+	merged := mergeBorderLines("\x1b[31m└─┘\x1b[0m", "╭───╮")
+
+	if merged != "├─┴─╮" {
+		t.Errorf("expected %q, got %q", "├─┴─╮", merged)
+	}
+}
+
+func TestMergeBorderLines_KeepsColorsOfDrawnCharacters(t *testing.T) {
+	merged := mergeBorderLines("\x1b[31m└───┘\x1b[0m", "\x1b[34m╭─╮\x1b[0m")
+
+	expected := "\x1b[34m├─┬\x1b[0m\x1b[31m─┘\x1b[0m"
+	if merged != expected {
+		t.Errorf("expected %q, got %q", expected, merged)
+	}
+}
+
+func TestCollapse_Row_SharesVerticalEdge(t *testing.T) {
+	root := Box(BoxProps{Direction: Row, Gap: 3, CollapseAdjacentBorders: true},
+		borderedText("ab", BorderSingle),
+		borderedText("cd", BorderSingle),
+	)
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+	output := StripANSI(root.Render(tree.Layout))
+
+	expected := "┌──┬──┐\n│ab│cd│\n└──┴──┘"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+	if tree.Layout.Width != 7 || tree.Children[1].Layout.X != 3 {
+		t.Errorf("expected width 7 with second box at x=3, got width %d and x=%d", tree.Layout.Width, tree.Children[1].Layout.X)
+	}
+}
+
+func TestCollapse_Disabled_KeepsBothBorders(t *testing.T) {
+	root := Box(BoxProps{Direction: Column},
+		borderedText("a", BorderSingle),
+		borderedText("b", BorderSingle),
+	)
+
+	if size := root.Measure(80, 24); size.Height != 6 {
+		t.Errorf("expected both borders to take space, got height %d", size.Height)
+	}
+}
+
+func TestCollapse_UnborderedNeighbour_KeepsGap(t *testing.T) {
+	props := BoxProps{Gap: 1, CollapseAdjacentBorders: true}
+	children := []Component{borderedText("a", BorderSingle), Text("b")}
+
+	if spacing := childSpacing(props, children, 0); spacing != 1 {
+		t.Errorf("expected gap 1, got %d", spacing)
+	}
+}
+
+func TestMergeBorderRunes(t *testing.T) {
+	tests := []struct {
+		a, b, expected rune
+	}{
+		{'└', '┌', '├'},
+		{'┘', '┐', '┤'},
+		{'─', '┌', '┬'},
+		{'┘', '─', '┴'},
+		{'│', '─', '┼'},
+		{'╚', '╔', '╠'},
+		{'┘', '╔', '╔'},
+		{'x', ' ', 'x'},
+		{' ', '─', '─'},
+	}
+
+	for _, tt := range tests {
+		if got := mergeBorderRunes(tt.a, tt.b); got != tt.expected {
+			t.Errorf("merge(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+func TestSplitRunes_KeepEscapeSequences(t *testing.T) {
+	before, r, after := splitLastRune("\x1b[31mab│\x1b[0m")
+	if before != "\x1b[31mab" || r != '│' || after != "\x1b[0m" {
+		t.Errorf("unexpected split %q %q %q", before, r, after)
+	}
+
+	first, rest := splitFirstRune("\x1b[1m│cd")
+	if first != '│' || rest != "\x1b[1mcd" {
+		t.Errorf("unexpected split %q %q", first, rest)
+	}
+}
@@ -60,3 +60,22 @@ func (f ComponentFunc) Key() string {
 func (f ComponentFunc) Measure(availableWidth, availableHeight int) Size {
 	return f().Measure(availableWidth, availableHeight)
 }
+
+// Compose returns a ComponentFunc that builds inner's component and passes it
+// to outer, enabling decorators such as error boundaries or themes:
+//
+//	root := Compose(withBorder, page)
+//
+// Compose calls can be nested to chain several decorators; the outermost call
+// wraps last. A nil outer returns inner unchanged, and a nil inner returns nil.
+func Compose(outer func(Component) Component, inner ComponentFunc) ComponentFunc {
+	if inner == nil {
+		return nil
+	}
+	if outer == nil {
+		return inner
+	}
+	return func() Component {
+		return outer(inner())
+	}
+}
@@ -292,3 +292,54 @@ func TestComponentFunc_Measure_DelegatesToFunction(t *testing.T) {
 		t.Errorf("expected Height=40, got %d", size.Height)
 	}
 }
+
+func TestCompose_WrapsInnerComponent(t *testing.T) {
+	inner := ComponentFunc(func() Component { return testComponent{key: "inner"} })
+	outer := func(c Component) Component {
+		return testComponent{key: "outer", children: []Component{c}}
+	}
+
+	result := Compose(outer, inner)()
+
+	if result.Key() != "outer" || result.Children()[0].Key() != "inner" {
+		t.Errorf("expected outer wrapping inner, got %s with %v", result.Key(), result.Children())
+	}
+}
+
+func TestCompose_Nested_AppliesOutermostLast(t *testing.T) {
+	var order []string
+	wrap := func(name string) func(Component) Component {
+		return func(c Component) Component {
+			order = append(order, name)
+			return testComponent{key: name, children: []Component{c}}
+		}
+	}
+	inner := ComponentFunc(func() Component { return testComponent{key: "page"} })
+
+	result := Compose(wrap("theme"), Compose(wrap("boundary"), inner))()
+
+	if result.Key() != "theme" || result.Children()[0].Key() != "boundary" {
+		t.Errorf("unexpected nesting: %s > %s", result.Key(), result.Children()[0].Key())
+	}
+	if len(order) != 2 || order[0] != "boundary" || order[1] != "theme" {
+		t.Errorf("expected boundary then theme, got %v", order)
+	}
+}
+
+func TestCompose_NilOuter_ReturnsInner(t *testing.T) {
+	inner := ComponentFunc(func() Component { return testComponent{key: "inner"} })
+
+	result := Compose(nil, inner)
+
+	if result == nil || result().Key() != "inner" {
+		t.Error("expected inner to be returned unchanged")
+	}
+}
+
+func TestCompose_NilInner_ReturnsNil(t *testing.T) {
+	result := Compose(func(c Component) Component { return c }, nil)
+
+	if result != nil {
+		t.Error("expected nil ComponentFunc")
+	}
+}
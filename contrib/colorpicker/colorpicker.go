@@ -0,0 +1,239 @@
+// Package colorpicker provides an interactive RGB color picker built on RuneTUI.
+//
+// The picker shows one slider per channel, a hex input field and a swatch of
+// the current color. Like the components in the runetui package it is
+// stateless: the application owns the color, the focused field and the hex
+// draft, and updates them from the messages produced by HandleKey.
+//
+// Example usage:
+//
+//	func view() runetui.Component {
+//	    return colorpicker.ColorPicker(state.color, colorpicker.ColorPickerProps{
+//	        FocusedIndex: state.focused,
+//	        HexInput:     state.draft,
+//	    })
+//	}
+//
+//	func update(msg tea.Msg) tea.Cmd {
+//	    switch msg := msg.(type) {
+//	    case colorpicker.ColorChangedMsg:
+//	        state.color, state.draft = msg.Hex, ""
+//	    case colorpicker.HexInputMsg:
+//	        state.draft = msg.Value
+//	    case colorpicker.FocusMsg:
+//	        state.focused = msg.Index
+//	    case colorpicker.ColorPickedMsg:
+//	        return tea.Quit
+//	    }
+//	    return colorpicker.HandleKey(state.color, props, msg)
+//	}
+package colorpicker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// Fields of the picker, in focus order.
+const (
+	FieldRed = iota
+	FieldGreen
+	FieldBlue
+	FieldHex
+	fieldCount
+)
+
+// DefaultSliderWidth is the slider width used when ColorPickerProps.SliderWidth is zero.
+const DefaultSliderWidth = 16
+
+// DefaultStep is the channel change per key press used when ColorPickerProps.Step is zero.
+const DefaultStep = 8
+
+// ColorPickerProps defines properties for the ColorPicker component.
+// FocusedIndex is one of FieldRed, FieldGreen, FieldBlue or FieldHex.
+// HexInput is the hex value being typed; when empty the hex field shows the
+// current color.
+type ColorPickerProps struct {
+	FocusedIndex int
+	HexInput     string
+	SliderWidth  int
+	Step         int
+	Key          string
+}
+
+// ColorChangedMsg is dispatched when a slider moves or a complete hex value is
+// typed. The application should store Hex and clear its hex draft.
+type ColorChangedMsg struct {
+	Hex string
+}
+
+// HexInputMsg is dispatched when the hex draft changes but is not yet a
+// complete color. The application should store Value as its hex draft.
+type HexInputMsg struct {
+	Value string
+}
+
+// FocusMsg is dispatched when the user moves focus to the field at Index.
+type FocusMsg struct {
+	Index int
+}
+
+// ColorPickedMsg is dispatched when the user confirms the color with Enter.
+type ColorPickedMsg struct {
+	Hex string
+}
+
+// ColorPicker renders RGB sliders, a hex field and a swatch for the color
+// given as "#rrggbb". Invalid colors are shown as black.
+func ColorPicker(hex string, props ColorPickerProps) runetui.Component {
+	props = withDefaults(props)
+	rgb, _ := ParseHex(hex)
+
+	hexValue := FormatHex(rgb)
+	if props.HexInput != "" {
+		hexValue = props.HexInput
+	}
+	swatch := strings.Repeat(" ", props.SliderWidth+6)
+
+	return runetui.Box(runetui.BoxProps{Key: props.Key},
+		slider("R", rgb[0], props, FieldRed),
+		slider("G", rgb[1], props, FieldGreen),
+		slider("B", rgb[2], props, FieldBlue),
+		runetui.Text(hexValue, fieldStyle(props, FieldHex, "Hex ")),
+		runetui.Text(swatch, runetui.TextProps{Background: FormatHex(rgb)}),
+		runetui.Text(swatch, runetui.TextProps{Background: FormatHex(rgb)}),
+	)
+}
+
+// slider renders one channel as a filled bar followed by its value.
+func slider(label string, value uint8, props ColorPickerProps, field int) runetui.Component {
+	filled := int(value) * props.SliderWidth / 255
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", props.SliderWidth-filled)
+	return runetui.Text(fmt.Sprintf("%s %3d", bar, value), fieldStyle(props, field, label+" "))
+}
+
+// fieldStyle marks the focused field with "> " and bold text.
+func fieldStyle(props ColorPickerProps, field int, label string) runetui.TextProps {
+	if props.FocusedIndex == field {
+		return runetui.TextProps{Prefix: "> " + label, Bold: true}
+	}
+	return runetui.TextProps{Prefix: "  " + label}
+}
+
+// withDefaults fills in the default slider width and step.
+func withDefaults(props ColorPickerProps) ColorPickerProps {
+	if props.SliderWidth <= 0 {
+		props.SliderWidth = DefaultSliderWidth
+	}
+	if props.Step <= 0 {
+		props.Step = DefaultStep
+	}
+	return props
+}
+
+// HandleKey returns the command for a key press on the picker showing hex.
+// Up/down and tab/shift+tab dispatch FocusMsg; left/right (or h/l) on a
+// slider dispatch ColorChangedMsg; hex digits and backspace on the hex field
+// dispatch HexInputMsg, or ColorChangedMsg once six digits are typed; enter
+// dispatches ColorPickedMsg. It returns nil for other messages.
+func HandleKey(hex string, props ColorPickerProps, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	props = withDefaults(props)
+	rgb, _ := ParseHex(hex)
+
+	switch key.String() {
+	case "up", "shift+tab":
+		return focusCmd((props.FocusedIndex + fieldCount - 1) % fieldCount)
+	case "down", "tab":
+		return focusCmd((props.FocusedIndex + 1) % fieldCount)
+	case "enter":
+		picked := FormatHex(rgb)
+		return func() tea.Msg { return ColorPickedMsg{Hex: picked} }
+	}
+
+	if props.FocusedIndex == FieldHex {
+		return hexInputCmd(props.HexInput, key)
+	}
+	if props.FocusedIndex < FieldRed || props.FocusedIndex > FieldBlue {
+		return nil
+	}
+	switch key.String() {
+	case "left", "h":
+		return adjustCmd(rgb, props.FocusedIndex, -props.Step)
+	case "right", "l":
+		return adjustCmd(rgb, props.FocusedIndex, props.Step)
+	}
+	return nil
+}
+
+func focusCmd(index int) tea.Cmd {
+	return func() tea.Msg { return FocusMsg{Index: index} }
+}
+
+// adjustCmd changes one channel by delta, clamped to 0-255.
+func adjustCmd(rgb [3]uint8, channel, delta int) tea.Cmd {
+	rgb[channel] = uint8(max(0, min(255, int(rgb[channel])+delta)))
+	changed := FormatHex(rgb)
+	return func() tea.Msg { return ColorChangedMsg{Hex: changed} }
+}
+
+// hexInputCmd edits the hex draft with a typed digit or backspace.
+func hexInputCmd(draft string, key tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Type == tea.KeyBackspace:
+		if draft == "" {
+			return nil
+		}
+		draft = draft[:len(draft)-1]
+	case key.Type == tea.KeyRunes && isHexInput(draft, key.Runes):
+		draft += strings.ToLower(string(key.Runes))
+	default:
+		return nil
+	}
+
+	if rgb, ok := ParseHex(draft); ok {
+		changed := FormatHex(rgb)
+		return func() tea.Msg { return ColorChangedMsg{Hex: changed} }
+	}
+	return func() tea.Msg { return HexInputMsg{Value: draft} }
+}
+
+// isHexInput reports whether runes may be appended to draft: hex digits, or a
+// leading '#', up to a complete color.
+func isHexInput(draft string, runes []rune) bool {
+	for _, r := range runes {
+		switch {
+		case r == '#' && draft == "":
+		case strings.ContainsRune("0123456789abcdefABCDEF", r) && len(strings.TrimPrefix(draft, "#")) < 6:
+		default:
+			return false
+		}
+		draft += string(r)
+	}
+	return true
+}
+
+// ParseHex parses a color written as "#rrggbb" or "rrggbb".
+func ParseHex(hex string) ([3]uint8, bool) {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) != 6 {
+		return [3]uint8{}, false
+	}
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return [3]uint8{}, false
+	}
+	return [3]uint8{uint8(value >> 16), uint8(value >> 8), uint8(value)}, true
+}
+
+// FormatHex formats a color as "#rrggbb".
+func FormatHex(rgb [3]uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
@@ -0,0 +1,130 @@
+package colorpicker
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func render(hex string, props ColorPickerProps) string {
+	return rtest.RenderToString(func() runetui.Component { return ColorPicker(hex, props) }, 40, 10)
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestColorPicker_Snapshots(t *testing.T) {
+	rtest.AssertGoldenDir(t, "testdata", map[string]string{
+		"black_red_focused":      render("#000000", ColorPickerProps{}),
+		"white_blue_focused":     render("#ffffff", ColorPickerProps{FocusedIndex: FieldBlue}),
+		"mixed_hex_focused":      render("#80ff20", ColorPickerProps{FocusedIndex: FieldHex}),
+		"hex_draft":              render("#80ff20", ColorPickerProps{FocusedIndex: FieldHex, HexInput: "#12"}),
+		"narrow_sliders":         render("#408000", ColorPickerProps{SliderWidth: 8}),
+		"invalid_color_as_black": render("not a color", ColorPickerProps{FocusedIndex: FieldGreen}),
+	})
+}
+
+func TestColorPicker_ShowsChannelValuesAndHex(t *testing.T) {
+	output := runetui.StripANSI(render("#80ff20", ColorPickerProps{FocusedIndex: FieldGreen}))
+
+	runetui.AssertContainsText(t, output, "  R ████████░░░░░░░░ 128")
+	runetui.AssertContainsText(t, output, "> G ████████████████ 255")
+	runetui.AssertContainsText(t, output, "  B ██░░░░░░░░░░░░░░  32")
+	runetui.AssertContainsText(t, output, "  Hex #80ff20")
+}
+
+func TestHandleKey_SliderMovesDispatchColorChanged(t *testing.T) {
+	tests := []struct {
+		hex      string
+		focused  int
+		key      tea.KeyMsg
+		expected string
+	}{
+		{"#808080", FieldRed, tea.KeyMsg{Type: tea.KeyRight}, "#888080"},
+		{"#808080", FieldGreen, tea.KeyMsg{Type: tea.KeyLeft}, "#807880"},
+		{"#8080fc", FieldBlue, runes("l"), "#8080ff"},
+		{"#038080", FieldRed, runes("h"), "#008080"},
+	}
+
+	for _, tt := range tests {
+		cmd := HandleKey(tt.hex, ColorPickerProps{FocusedIndex: tt.focused}, tt.key)
+		if cmd == nil {
+			t.Fatalf("%s: expected a command", tt.hex)
+		}
+		if msg := cmd(); msg != (ColorChangedMsg{Hex: tt.expected}) {
+			t.Errorf("%s: expected %s, got %v", tt.hex, tt.expected, msg)
+		}
+	}
+}
+
+func TestHandleKey_HexTyping_UpdatesDraftThenColor(t *testing.T) {
+	props := ColorPickerProps{FocusedIndex: FieldHex, HexInput: "#12ab"}
+
+	if msg := HandleKey("#000000", props, runes("C"))(); msg != (HexInputMsg{Value: "#12abc"}) {
+		t.Errorf("expected draft #12abc, got %v", msg)
+	}
+
+	props.HexInput = "#12abc"
+	if msg := HandleKey("#000000", props, runes("d"))(); msg != (ColorChangedMsg{Hex: "#12abcd"}) {
+		t.Errorf("expected color #12abcd, got %v", msg)
+	}
+
+	if msg := HandleKey("#000000", props, tea.KeyMsg{Type: tea.KeyBackspace})(); msg != (HexInputMsg{Value: "#12ab"}) {
+		t.Errorf("expected draft #12ab after backspace, got %v", msg)
+	}
+}
+
+func TestHandleKey_HexTyping_RejectsInvalidInput(t *testing.T) {
+	props := ColorPickerProps{FocusedIndex: FieldHex}
+
+	for _, key := range []tea.KeyMsg{runes("z"), runes("l")} {
+		if cmd := HandleKey("#000000", props, key); cmd != nil {
+			t.Errorf("expected %q to be ignored, got %v", key.String(), cmd())
+		}
+	}
+	props.HexInput = "#"
+	if cmd := HandleKey("#000000", props, runes("#")); cmd != nil {
+		t.Error("expected a second '#' to be ignored")
+	}
+	props.HexInput = ""
+	if cmd := HandleKey("#000000", props, tea.KeyMsg{Type: tea.KeyBackspace}); cmd != nil {
+		t.Error("expected backspace on an empty draft to be ignored")
+	}
+}
+
+func TestHandleKey_FocusWrapsAround(t *testing.T) {
+	if msg := HandleKey("#000000", ColorPickerProps{FocusedIndex: FieldRed}, tea.KeyMsg{Type: tea.KeyUp})(); msg != (FocusMsg{Index: FieldHex}) {
+		t.Errorf("expected focus to wrap to hex, got %v", msg)
+	}
+	if msg := HandleKey("#000000", ColorPickerProps{FocusedIndex: FieldHex}, tea.KeyMsg{Type: tea.KeyTab})(); msg != (FocusMsg{Index: FieldRed}) {
+		t.Errorf("expected focus to wrap to red, got %v", msg)
+	}
+}
+
+func TestHandleKey_Enter_DispatchesColorPicked(t *testing.T) {
+	msg := HandleKey("#ABCDEF", ColorPickerProps{}, tea.KeyMsg{Type: tea.KeyEnter})()
+
+	if msg != (ColorPickedMsg{Hex: "#abcdef"}) {
+		t.Errorf("expected picked #abcdef, got %v", msg)
+	}
+}
+
+func TestHandleKey_NonKeyMsg_ReturnsNil(t *testing.T) {
+	if HandleKey("#000000", ColorPickerProps{}, tea.WindowSizeMsg{}) != nil {
+		t.Error("expected nil for non-key messages")
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	if rgb, ok := ParseHex("10a0ff"); !ok || rgb != [3]uint8{0x10, 0xa0, 0xff} {
+		t.Errorf("unexpected result %v %v", rgb, ok)
+	}
+	for _, invalid := range []string{"", "#fff", "#gggggg", "#1234567"} {
+		if _, ok := ParseHex(invalid); ok {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
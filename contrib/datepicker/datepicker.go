@@ -0,0 +1,243 @@
+// Package datepicker provides an interactive date and time picker built on
+// RuneTUI.
+//
+// The picker shows a month calendar for the date and hour, minute and second
+// fields for the time, followed by the current value in the chosen format.
+// Tab moves between the calendar and the time fields.
+//
+// Example usage:
+//
+//	when, err := datepicker.New(datepicker.DatePickerProps{
+//	    Initial: time.Now(),
+//	    MinDate: time.Now(),
+//	}).Run()
+//	if errors.Is(err, datepicker.ErrCancelled) {
+//	    return
+//	}
+package datepicker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// ErrCancelled is returned by Run when the user quits without picking a time.
+var ErrCancelled = errors.New("date picker cancelled")
+
+// DefaultFormat is the layout used to show the value when DatePickerProps.Format is empty.
+const DefaultFormat = "2006-01-02 15:04:05"
+
+// Fields of the picker, in focus order.
+const (
+	FieldDate = iota
+	FieldHour
+	FieldMinute
+	FieldSecond
+	fieldCount
+)
+
+// Shortcuts lists the key bindings shown while the picker runs.
+var Shortcuts = []runetui.Shortcut{
+	{Key: "tab", Description: "next field"},
+	{Key: "←/→/↑/↓", Description: "change"},
+	{Key: "pgup/pgdown", Description: "month"},
+	{Key: "enter", Description: "pick"},
+	{Key: "esc", Description: "cancel"},
+}
+
+// DatePickerProps configures a DatePicker. Zero MinDate and MaxDate leave the
+// range open on that side.
+type DatePickerProps struct {
+	Initial time.Time
+	MinDate time.Time
+	MaxDate time.Time
+	Format  string
+	Key     string
+}
+
+// DatePickedMsg is dispatched when the user confirms the value with Enter.
+type DatePickedMsg struct {
+	Time time.Time
+}
+
+// CancelledMsg is dispatched when the user dismisses the picker with Esc.
+type CancelledMsg struct{}
+
+// DatePicker is an interactive date and time chooser. Use View and Update to
+// embed it in an app, or Run for a blocking one-shot picker.
+type DatePicker struct {
+	props  DatePickerProps
+	value  time.Time
+	focus  int
+	picked bool
+}
+
+// New creates a DatePicker showing props.Initial, or the current time when it
+// is zero, moved into the allowed range.
+func New(props DatePickerProps) *DatePicker {
+	if props.Format == "" {
+		props.Format = DefaultFormat
+	}
+	initial := props.Initial
+	if initial.IsZero() {
+		initial = time.Now()
+	}
+	dp := &DatePicker{props: props}
+	dp.set(initial.Truncate(time.Second))
+	return dp
+}
+
+// Run shows the picker and blocks until the user picks a value or quits. It
+// returns ErrCancelled when no value was picked.
+func (dp *DatePicker) Run() (time.Time, error) {
+	update := func(msg tea.Msg) tea.Cmd {
+		switch msg.(type) {
+		case DatePickedMsg, CancelledMsg:
+			return tea.Quit
+		}
+		return dp.Update(msg)
+	}
+	app := runetui.New(dp.View, runetui.WithUpdate(update), runetui.WithShortcuts(Shortcuts...))
+	if err := app.Run(); err != nil {
+		return time.Time{}, err
+	}
+	if !dp.picked {
+		return time.Time{}, ErrCancelled
+	}
+	return dp.value, nil
+}
+
+// Value returns the date and time currently shown.
+func (dp *DatePicker) Value() time.Time {
+	return dp.value
+}
+
+// Focused returns the focused field: FieldDate, FieldHour, FieldMinute or FieldSecond.
+func (dp *DatePicker) Focused() int {
+	return dp.focus
+}
+
+// Update handles navigation keys. Enter dispatches DatePickedMsg and Esc
+// dispatches CancelledMsg.
+func (dp *DatePicker) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch key.String() {
+	case "tab":
+		dp.focus = (dp.focus + 1) % fieldCount
+	case "shift+tab":
+		dp.focus = (dp.focus + fieldCount - 1) % fieldCount
+	case "enter":
+		dp.picked = true
+		picked := dp.value
+		return func() tea.Msg { return DatePickedMsg{Time: picked} }
+	case "esc":
+		return func() tea.Msg { return CancelledMsg{} }
+	case "pgup", "<":
+		dp.set(dp.value.AddDate(0, -1, 0))
+	case "pgdown", ">":
+		dp.set(dp.value.AddDate(0, 1, 0))
+	default:
+		if dp.focus == FieldDate {
+			dp.moveDate(key.String())
+		} else {
+			dp.moveTime(key.String())
+		}
+	}
+	return nil
+}
+
+// moveDate moves the date by a day with left/right and a week with up/down.
+func (dp *DatePicker) moveDate(key string) {
+	switch key {
+	case "left", "h":
+		dp.set(dp.value.AddDate(0, 0, -1))
+	case "right", "l":
+		dp.set(dp.value.AddDate(0, 0, 1))
+	case "up", "k":
+		dp.set(dp.value.AddDate(0, 0, -7))
+	case "down", "j":
+		dp.set(dp.value.AddDate(0, 0, 7))
+	}
+}
+
+// moveTime steps the focused time field with up/down, wrapping within the
+// field so the date is left unchanged.
+func (dp *DatePicker) moveTime(key string) {
+	step := 0
+	switch key {
+	case "up", "k", "right", "l":
+		step = 1
+	case "down", "j", "left", "h":
+		step = -1
+	default:
+		return
+	}
+
+	h, m, s := dp.value.Clock()
+	switch dp.focus {
+	case FieldHour:
+		h = (h + step + 24) % 24
+	case FieldMinute:
+		m = (m + step + 60) % 60
+	case FieldSecond:
+		s = (s + step + 60) % 60
+	}
+	y, mo, d := dp.value.Date()
+	dp.set(time.Date(y, mo, d, h, m, s, 0, dp.value.Location()))
+}
+
+// set stores t clamped to the allowed range.
+func (dp *DatePicker) set(t time.Time) {
+	if !dp.props.MinDate.IsZero() && t.Before(dp.props.MinDate) {
+		t = dp.props.MinDate
+	}
+	if !dp.props.MaxDate.IsZero() && t.After(dp.props.MaxDate) {
+		t = dp.props.MaxDate
+	}
+	dp.value = t
+}
+
+// View renders the calendar, the time fields and the formatted value.
+func (dp *DatePicker) View() runetui.Component {
+	headerStyle := runetui.TextProps{}
+	if dp.focus == FieldDate {
+		headerStyle = runetui.TextProps{Bold: true, Prefix: "> "}
+	}
+	h, m, s := dp.value.Clock()
+
+	return runetui.VStackWithProps(runetui.StackProps{Key: dp.props.Key},
+		runetui.Calendar(runetui.CalendarProps{
+			Year:        dp.value.Year(),
+			Month:       int(dp.value.Month()),
+			SelectedDay: dp.value.Day(),
+			HeaderStyle: headerStyle,
+			Key:         "calendar",
+		}),
+		runetui.HStack(
+			runetui.Text("Time "),
+			dp.field(h, FieldHour),
+			runetui.Text(":"),
+			dp.field(m, FieldMinute),
+			runetui.Text(":"),
+			dp.field(s, FieldSecond),
+		),
+		runetui.Text(dp.value.Format(dp.props.Format), runetui.TextProps{Italic: true, Key: "value"}),
+	)
+}
+
+// field renders a two-digit time field, highlighted when focused.
+func (dp *DatePicker) field(value, field int) runetui.Component {
+	props := runetui.TextProps{}
+	if dp.focus == field {
+		props = runetui.TextProps{Bold: true, Underline: true}
+	}
+	return runetui.Text(fmt.Sprintf("%02d", value), props)
+}
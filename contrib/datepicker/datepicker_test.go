@@ -0,0 +1,139 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+var initial = time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "left":
+		return tea.KeyMsg{Type: tea.KeyLeft}
+	case "right":
+		return tea.KeyMsg{Type: tea.KeyRight}
+	case "pgdown":
+		return tea.KeyMsg{Type: tea.KeyPgDown}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func press(dp *DatePicker, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		cmd = dp.Update(key(k))
+	}
+	return cmd
+}
+
+func TestUpdate_DateKeys_MoveByDayWeekAndMonth(t *testing.T) {
+	tests := []struct {
+		keys     []string
+		expected time.Time
+	}{
+		{[]string{"right"}, initial.AddDate(0, 0, 1)},
+		{[]string{"left", "left"}, initial.AddDate(0, 0, -2)},
+		{[]string{"down"}, initial.AddDate(0, 0, 7)},
+		{[]string{"up"}, initial.AddDate(0, 0, -7)},
+		{[]string{"pgdown"}, initial.AddDate(0, 1, 0)},
+		{[]string{"<"}, initial.AddDate(0, -1, 0)},
+	}
+
+	for _, tt := range tests {
+		dp := New(DatePickerProps{Initial: initial})
+		press(dp, tt.keys...)
+		if !dp.Value().Equal(tt.expected) {
+			t.Errorf("%v: expected %v, got %v", tt.keys, tt.expected, dp.Value())
+		}
+	}
+}
+
+func TestUpdate_TimeFields_WrapWithoutChangingDate(t *testing.T) {
+	dp := New(DatePickerProps{Initial: time.Date(2026, time.March, 14, 23, 59, 0, 0, time.UTC)})
+
+	press(dp, "tab", "up")
+	press(dp, "tab", "up")
+	press(dp, "tab", "down")
+
+	expected := time.Date(2026, time.March, 14, 0, 0, 59, 0, time.UTC)
+	if !dp.Value().Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, dp.Value())
+	}
+	if dp.Focused() != FieldSecond {
+		t.Errorf("expected second field focused, got %d", dp.Focused())
+	}
+}
+
+func TestUpdate_ClampsToMinAndMax(t *testing.T) {
+	min := initial.AddDate(0, 0, -1)
+	max := initial.AddDate(0, 0, 3)
+	dp := New(DatePickerProps{Initial: initial, MinDate: min, MaxDate: max})
+
+	press(dp, "down")
+	if !dp.Value().Equal(max) {
+		t.Errorf("expected clamp to max %v, got %v", max, dp.Value())
+	}
+	press(dp, "up")
+	if !dp.Value().Equal(min) {
+		t.Errorf("expected clamp to min %v, got %v", min, dp.Value())
+	}
+}
+
+func TestNew_InitialOutsideRange_IsClamped(t *testing.T) {
+	dp := New(DatePickerProps{Initial: initial, MinDate: initial.AddDate(1, 0, 0)})
+
+	if !dp.Value().Equal(initial.AddDate(1, 0, 0)) {
+		t.Errorf("expected initial value clamped to MinDate, got %v", dp.Value())
+	}
+}
+
+func TestUpdate_Enter_DispatchesDatePicked(t *testing.T) {
+	dp := New(DatePickerProps{Initial: initial})
+
+	cmd := press(dp, "right", "enter")
+
+	msg, ok := cmd().(DatePickedMsg)
+	if !ok || !msg.Time.Equal(initial.AddDate(0, 0, 1)) {
+		t.Errorf("expected DatePickedMsg for the next day, got %v", cmd())
+	}
+}
+
+func TestUpdate_Esc_DispatchesCancelled(t *testing.T) {
+	dp := New(DatePickerProps{Initial: initial})
+
+	if msg := press(dp, "esc")(); msg != (CancelledMsg{}) {
+		t.Errorf("expected CancelledMsg, got %v", msg)
+	}
+}
+
+func TestView_ShowsCalendarTimeAndFormattedValue(t *testing.T) {
+	dp := New(DatePickerProps{Initial: initial, Format: time.RFC822})
+	press(dp, "tab")
+
+	output := runetui.StripANSI(rtest.RenderToString(dp.View, 40, 14))
+
+	runetui.AssertContainsText(t, output, "March 2026")
+	runetui.AssertContainsText(t, output, "Time 09:30:00")
+	runetui.AssertContainsText(t, output, "14 Mar 26 09:30 UTC")
+}
+
+func TestView_Snapshot(t *testing.T) {
+	dp := New(DatePickerProps{Initial: initial})
+
+	rtest.AssertSnapshot(t, "datepicker_initial", rtest.RenderToString(dp.View, 40, 14))
+}
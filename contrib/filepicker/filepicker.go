@@ -0,0 +1,338 @@
+// Package filepicker provides a keyboard-driven file picker built on RuneTUI.
+//
+// The picker shows a breadcrumb of the current directory, a list of entries
+// with directories first, a preview of the highlighted text file and a legend
+// of its shortcuts. Hidden files can be toggled and files can be filtered by
+// extension.
+//
+// Example usage:
+//
+//	path, err := filepicker.New(filepicker.Props{
+//	    Dir:        ".",
+//	    Extensions: []string{".go", ".md"},
+//	}).Run()
+//	if errors.Is(err, filepicker.ErrCancelled) {
+//	    return
+//	}
+package filepicker
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// ErrCancelled is returned by Run when the user quits without choosing a file.
+var ErrCancelled = errors.New("file picker cancelled")
+
+// DefaultPreviewLines is the number of lines previewed when Props.PreviewLines is zero.
+const DefaultPreviewLines = 10
+
+// DefaultListHeight is the number of visible entries when Props.ListHeight is zero.
+const DefaultListHeight = 15
+
+// Shortcuts lists the key bindings shown in the picker's legend.
+var Shortcuts = []runetui.Shortcut{
+	{Key: "↑/↓", Description: "move"},
+	{Key: "enter", Description: "open/select"},
+	{Key: "backspace", Description: "parent"},
+	{Key: ".", Description: "hidden files"},
+	{Key: "esc", Description: "cancel"},
+}
+
+// Props configures a FilePicker.
+type Props struct {
+	// Dir is the starting directory. The working directory is used when empty.
+	Dir string
+	// ShowHidden lists entries whose names start with a dot.
+	ShowHidden bool
+	// Extensions limits the listed files to these extensions, such as ".go".
+	// Directories are always listed. All files are listed when empty.
+	Extensions []string
+	// PreviewLines is the number of lines shown in the preview pane.
+	PreviewLines int
+	// ListHeight is the number of entries visible at once.
+	ListHeight int
+}
+
+// entry is a single item in the current directory listing.
+type entry struct {
+	name  string
+	isDir bool
+}
+
+// FilePicker is an interactive file chooser. Use View and Update to embed it
+// in an app, or Run for a blocking one-shot picker.
+type FilePicker struct {
+	props      Props
+	dir        string
+	entries    []entry
+	cursor     int
+	offset     int
+	preview    []string
+	showHidden bool
+	selected   string
+	done       bool
+	err        error
+}
+
+// New creates a FilePicker starting in props.Dir.
+func New(props Props) *FilePicker {
+	if props.PreviewLines <= 0 {
+		props.PreviewLines = DefaultPreviewLines
+	}
+	if props.ListHeight <= 0 {
+		props.ListHeight = DefaultListHeight
+	}
+	fp := &FilePicker{props: props, showHidden: props.ShowHidden}
+	dir, err := filepath.Abs(props.Dir)
+	if err != nil {
+		fp.err = err
+		return fp
+	}
+	fp.chdir(dir)
+	return fp
+}
+
+// Run shows the picker full screen and blocks until the user selects a file or
+// quits. It returns ErrCancelled when no file was selected.
+func (fp *FilePicker) Run() (string, error) {
+	app := runetui.New(fp.View, runetui.WithUpdate(fp.Update), runetui.WithShortcuts(Shortcuts...))
+	if err := app.Run(); err != nil {
+		return "", err
+	}
+	if fp.selected == "" {
+		return "", ErrCancelled
+	}
+	return fp.selected, nil
+}
+
+// Dir returns the directory currently shown.
+func (fp *FilePicker) Dir() string {
+	return fp.dir
+}
+
+// Selected returns the chosen file, or "" if none has been chosen yet.
+func (fp *FilePicker) Selected() string {
+	return fp.selected
+}
+
+// Err returns the last error encountered while reading a directory, if any.
+func (fp *FilePicker) Err() error {
+	return fp.err
+}
+
+// Update handles navigation keys. It returns tea.Quit once a file is selected
+// or the picker is cancelled.
+func (fp *FilePicker) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || fp.done {
+		return nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		fp.move(-1)
+	case "down", "j":
+		fp.move(1)
+	case "enter", "right", "l":
+		return fp.open()
+	case "backspace", "left", "h":
+		fp.chdir(filepath.Dir(fp.dir))
+	case ".":
+		fp.showHidden = !fp.showHidden
+		fp.chdir(fp.dir)
+	case "esc", "q":
+		fp.done = true
+		return tea.Quit
+	}
+	return nil
+}
+
+// move shifts the cursor by delta, scrolling the list to keep it visible.
+func (fp *FilePicker) move(delta int) {
+	if len(fp.entries) == 0 {
+		return
+	}
+	fp.cursor = max(0, min(fp.cursor+delta, len(fp.entries)-1))
+	if fp.cursor < fp.offset {
+		fp.offset = fp.cursor
+	}
+	if fp.cursor >= fp.offset+fp.props.ListHeight {
+		fp.offset = fp.cursor - fp.props.ListHeight + 1
+	}
+	fp.preview = fp.readPreview()
+}
+
+// open enters the highlighted directory or selects the highlighted file.
+func (fp *FilePicker) open() tea.Cmd {
+	if len(fp.entries) == 0 {
+		return nil
+	}
+	current := fp.entries[fp.cursor]
+	path := filepath.Join(fp.dir, current.name)
+	if current.isDir {
+		fp.chdir(path)
+		return nil
+	}
+	fp.selected = path
+	fp.done = true
+	return tea.Quit
+}
+
+// chdir lists dir and resets the cursor. On error the current listing is kept.
+func (fp *FilePicker) chdir(dir string) {
+	entries, err := fp.list(dir)
+	if err != nil {
+		fp.err = err
+		return
+	}
+	previous := fp.dir
+	fp.dir, fp.entries, fp.err = dir, entries, nil
+	fp.cursor, fp.offset = 0, 0
+
+	// Keep the directory we came from highlighted when going up.
+	if filepath.Dir(previous) == dir {
+		for i, e := range entries {
+			if e.name == filepath.Base(previous) {
+				fp.move(i)
+				break
+			}
+		}
+	}
+	fp.preview = fp.readPreview()
+}
+
+// list reads dir and returns its visible entries, directories first.
+func (fp *FilePicker) list(dir string) ([]entry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []entry
+	for _, d := range dirEntries {
+		name := d.Name()
+		if !fp.showHidden && strings.HasPrefix(name, ".") {
+			continue
+		}
+		isDir := d.IsDir()
+		if !isDir && !fp.matchesExtension(name) {
+			continue
+		}
+		entries = append(entries, entry{name: name, isDir: isDir})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].isDir && !entries[j].isDir
+	})
+	return entries, nil
+}
+
+// matchesExtension reports whether name passes the extension filter.
+func (fp *FilePicker) matchesExtension(name string) bool {
+	if len(fp.props.Extensions) == 0 {
+		return true
+	}
+	ext := filepath.Ext(name)
+	for _, allowed := range fp.props.Extensions {
+		if strings.EqualFold(ext, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// View renders the breadcrumb, the listing with its preview and the legend.
+func (fp *FilePicker) View() runetui.Component {
+	return runetui.VStack(
+		runetui.Text(breadcrumb(fp.dir), runetui.TextProps{Bold: true, Key: "breadcrumb"}),
+		runetui.HStackWithProps(runetui.StackProps{Gap: 1},
+			fp.listView(),
+			fp.previewView(),
+		),
+		fp.statusView(),
+	)
+}
+
+// listView renders the visible slice of entries with the cursor marked.
+func (fp *FilePicker) listView() runetui.Component {
+	lines := make([]runetui.Component, 0, fp.props.ListHeight)
+	if len(fp.entries) == 0 {
+		lines = append(lines, runetui.Text("(empty)", runetui.TextProps{Italic: true}))
+	}
+	end := min(fp.offset+fp.props.ListHeight, len(fp.entries))
+	for i := fp.offset; i < end; i++ {
+		e := fp.entries[i]
+		name := e.name
+		if e.isDir {
+			name += "/"
+		}
+		props := runetui.TextProps{Prefix: "  "}
+		if i == fp.cursor {
+			props = runetui.TextProps{Prefix: "> ", Bold: true}
+		}
+		lines = append(lines, runetui.Text(name, props))
+	}
+	return runetui.Box(runetui.BoxProps{Border: runetui.BorderRounded, Key: "entries"}, lines...)
+}
+
+// previewView renders the first lines of the highlighted text file.
+func (fp *FilePicker) previewView() runetui.Component {
+	var lines []runetui.Component
+	for _, line := range fp.preview {
+		lines = append(lines, runetui.Text(line))
+	}
+	return runetui.Box(runetui.BoxProps{Border: runetui.BorderRounded, Key: "preview"}, lines...)
+}
+
+// statusView renders the last error, if any.
+func (fp *FilePicker) statusView() runetui.Component {
+	if fp.err == nil {
+		return nil
+	}
+	return runetui.Text(fp.err.Error(), runetui.TextProps{Color: "1", Key: "error"})
+}
+
+// readPreview returns up to PreviewLines lines of the highlighted file, or nil
+// for directories. Files that are not valid UTF-8 text show a placeholder.
+// It runs when the cursor or directory changes, not on every View.
+func (fp *FilePicker) readPreview() []string {
+	if len(fp.entries) == 0 || fp.entries[fp.cursor].isDir {
+		return nil
+	}
+	f, err := os.Open(filepath.Join(fp.dir, fp.entries[fp.cursor].name))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for len(lines) < fp.props.PreviewLines && scanner.Scan() {
+		line := scanner.Text()
+		if !utf8.ValidString(line) || strings.ContainsRune(line, 0) {
+			return []string{"(binary file)"}
+		}
+		lines = append(lines, strings.ReplaceAll(line, "\t", "    "))
+	}
+	return lines
+}
+
+// breadcrumb formats dir as its path segments separated by arrows.
+func breadcrumb(dir string) string {
+	parts := strings.Split(filepath.ToSlash(dir), "/")
+	var segments []string
+	for _, part := range parts {
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+	return strings.Join(append([]string{"/"}, segments...), " › ")
+}
@@ -0,0 +1,191 @@
+package filepicker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+// newTree creates a directory with the given files, creating parents as needed.
+func newTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func names(fp *FilePicker) []string {
+	var result []string
+	for _, e := range fp.entries {
+		result = append(result, e.name)
+	}
+	return result
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "backspace":
+		return tea.KeyMsg{Type: tea.KeyBackspace}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestNew_ListsDirectoriesFirstAndHidesDotFiles(t *testing.T) {
+	root := newTree(t, map[string]string{"b.txt": "", "a.txt": "", "sub/x": "", ".hidden": ""})
+
+	fp := New(Props{Dir: root})
+
+	if got := strings.Join(names(fp), ","); got != "sub,a.txt,b.txt" {
+		t.Errorf("expected sub,a.txt,b.txt, got %s", got)
+	}
+}
+
+func TestUpdate_DotTogglesHiddenFiles(t *testing.T) {
+	root := newTree(t, map[string]string{"a.txt": "", ".hidden": ""})
+	fp := New(Props{Dir: root})
+
+	fp.Update(key("."))
+
+	if got := strings.Join(names(fp), ","); got != ".hidden,a.txt" {
+		t.Errorf("expected hidden file to be listed, got %s", got)
+	}
+}
+
+func TestNew_ExtensionsFilterFilesButNotDirectories(t *testing.T) {
+	root := newTree(t, map[string]string{"main.go": "", "README.MD": "", "data.json": "", "pkg/x.go": ""})
+
+	fp := New(Props{Dir: root, Extensions: []string{".go", ".md"}})
+
+	if got := strings.Join(names(fp), ","); got != "pkg,README.MD,main.go" {
+		t.Errorf("expected pkg,README.MD,main.go, got %s", got)
+	}
+}
+
+func TestUpdate_NavigatesIntoAndOutOfDirectories(t *testing.T) {
+	root := newTree(t, map[string]string{"a/inner.txt": "", "b/other.txt": ""})
+	fp := New(Props{Dir: root})
+
+	fp.Update(key("down"))
+	fp.Update(key("enter"))
+	if fp.Dir() != filepath.Join(root, "b") {
+		t.Fatalf("expected to enter b, got %s", fp.Dir())
+	}
+
+	fp.Update(key("backspace"))
+	if fp.Dir() != root || fp.entries[fp.cursor].name != "b" {
+		t.Errorf("expected to return to root with b highlighted, got %s at %d", fp.Dir(), fp.cursor)
+	}
+}
+
+func TestUpdate_EnterOnFile_SelectsAndQuits(t *testing.T) {
+	root := newTree(t, map[string]string{"notes.txt": "hi"})
+	fp := New(Props{Dir: root})
+
+	cmd := fp.Update(key("enter"))
+
+	if fp.Selected() != filepath.Join(root, "notes.txt") {
+		t.Errorf("expected notes.txt to be selected, got %q", fp.Selected())
+	}
+	if cmd == nil || cmd() != tea.Quit() {
+		t.Error("expected selection to quit")
+	}
+}
+
+func TestUpdate_Esc_CancelsWithoutSelection(t *testing.T) {
+	fp := New(Props{Dir: newTree(t, map[string]string{"a": ""})})
+
+	cmd := fp.Update(key("esc"))
+
+	if cmd == nil || fp.Selected() != "" {
+		t.Errorf("expected quit without selection, got %q", fp.Selected())
+	}
+}
+
+func TestMove_ScrollsToKeepCursorVisible(t *testing.T) {
+	root := newTree(t, map[string]string{"1": "", "2": "", "3": "", "4": ""})
+	fp := New(Props{Dir: root, ListHeight: 2})
+
+	for i := 0; i < 3; i++ {
+		fp.Update(key("down"))
+	}
+
+	if fp.cursor != 3 || fp.offset != 2 {
+		t.Errorf("expected cursor 3 at offset 2, got %d at %d", fp.cursor, fp.offset)
+	}
+	fp.Update(key("down"))
+	if fp.cursor != 3 {
+		t.Errorf("expected cursor to stop at the last entry, got %d", fp.cursor)
+	}
+}
+
+func TestView_ShowsBreadcrumbListingAndPreview(t *testing.T) {
+	root := newTree(t, map[string]string{"notes.txt": "first\nsecond\nthird"})
+	fp := New(Props{Dir: root, PreviewLines: 2})
+
+	output := rtest.RenderPlain(fp.View, 80, 24)
+
+	runetui.AssertContainsText(t, output, "› "+filepath.Base(root))
+	runetui.AssertContainsText(t, output, "> notes.txt")
+	runetui.AssertContainsText(t, output, "second")
+	if strings.Contains(output, "third") {
+		t.Error("expected preview to stop after PreviewLines lines")
+	}
+}
+
+func TestPreview_BinaryFile_ShowsPlaceholder(t *testing.T) {
+	fp := New(Props{Dir: newTree(t, map[string]string{"blob.bin": "a\x00b"})})
+
+	if got := fp.preview; len(got) != 1 || got[0] != "(binary file)" {
+		t.Errorf("expected binary placeholder, got %v", got)
+	}
+}
+
+func TestPreview_ReadOnCursorChangeNotOnView(t *testing.T) {
+	root := newTree(t, map[string]string{"a.txt": "old", "b.txt": "b"})
+	fp := New(Props{Dir: root})
+
+	if err := os.WriteFile(filepath.Join(root, "a.txt"), []byte("new"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if output := rtest.RenderPlain(fp.View, 80, 24); !strings.Contains(output, "old") {
+		t.Errorf("expected View to reuse the cached preview, got:\n%s", output)
+	}
+
+	fp.Update(key("down"))
+	fp.Update(key("k"))
+	runetui.AssertContainsText(t, rtest.RenderPlain(fp.View, 80, 24), "new")
+}
+
+func TestNew_MissingDir_ReportsError(t *testing.T) {
+	fp := New(Props{Dir: filepath.Join(t.TempDir(), "missing")})
+
+	if fp.Err() == nil {
+		t.Fatal("expected an error for a missing directory")
+	}
+	runetui.AssertContainsText(t, rtest.RenderPlain(fp.View, 80, 24), "(empty)")
+}
+
+func TestBreadcrumb_JoinsSegments(t *testing.T) {
+	if got := breadcrumb("/home/user/src"); got != "/ › home › user › src" {
+		t.Errorf("unexpected breadcrumb %q", got)
+	}
+}
@@ -0,0 +1,450 @@
+// Package form provides a declarative multi-step form builder built on RuneTUI.
+//
+// A form is a sequence of steps, each holding text, number, select and
+// checkbox fields. The form tracks the focused field, validates each step
+// before moving on and collects the values by field name.
+//
+// Example usage:
+//
+//	values, err := form.New(
+//	    form.Step{Title: "Project", Fields: []form.Field{
+//	        form.TextField{Name: "name", Label: "Name", Required: true},
+//	        form.SelectField{Name: "license", Label: "License", Options: []string{"MIT", "Apache-2.0"}},
+//	    }},
+//	    form.Step{Title: "Options", Fields: []form.Field{
+//	        form.NumberField{Name: "workers", Label: "Workers", Min: 1, Max: 16, Default: 4},
+//	        form.CheckboxField{Name: "git", Label: "Initialize git", Default: true},
+//	    }},
+//	).Run()
+//	if errors.Is(err, form.ErrCancelled) {
+//	    return
+//	}
+package form
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// ErrCancelled is returned by Run when the user quits before submitting.
+var ErrCancelled = errors.New("form cancelled")
+
+// ErrRequired is reported for a required TextField left empty.
+var ErrRequired = errors.New("required")
+
+// Shortcuts lists the key bindings shown while the form runs.
+var Shortcuts = []runetui.Shortcut{
+	{Key: "tab/↑/↓", Description: "move"},
+	{Key: "←/→", Description: "change"},
+	{Key: "space", Description: "toggle"},
+	{Key: "enter", Description: "next/submit"},
+	{Key: "pgup", Description: "back"},
+	{Key: "esc", Description: "cancel"},
+}
+
+// Step is one page of a form.
+type Step struct {
+	Title  string
+	Fields []Field
+}
+
+// Field is one input of a step: a TextField, NumberField, SelectField or
+// CheckboxField.
+type Field interface {
+	name() string
+	label() string
+	initial() interface{}
+}
+
+// TextField collects a string, edited as a runetui.TextInput. MaxLength and
+// Mask behave as in runetui.TextInputProps. Validate, when set, runs on the
+// value before the step is left.
+type TextField struct {
+	Name        string
+	Label       string
+	Placeholder string
+	Default     string
+	Required    bool
+	MaxLength   int
+	Mask        rune
+	Validate    func(string) error
+}
+
+func (f TextField) name() string         { return f.Name }
+func (f TextField) label() string        { return f.Label }
+func (f TextField) initial() interface{} { return f.Default }
+
+// NumberField collects an int. Left and right step the value by Step, and
+// digits can be typed directly. The range is only enforced when Max > Min.
+type NumberField struct {
+	Name    string
+	Label   string
+	Default int
+	Min     int
+	Max     int
+	Step    int
+}
+
+func (f NumberField) name() string         { return f.Name }
+func (f NumberField) label() string        { return f.Label }
+func (f NumberField) initial() interface{} { return f.Default }
+
+// SelectField collects one of Options, shown as a runetui.SelectList. Its
+// value is the chosen option string.
+type SelectField struct {
+	Name    string
+	Label   string
+	Options []string
+	Default int
+}
+
+func (f SelectField) name() string  { return f.Name }
+func (f SelectField) label() string { return f.Label }
+func (f SelectField) initial() interface{} {
+	if f.Default < 0 || f.Default >= len(f.Options) {
+		return ""
+	}
+	return f.Options[f.Default]
+}
+
+// CheckboxField collects a bool.
+type CheckboxField struct {
+	Name    string
+	Label   string
+	Default bool
+}
+
+func (f CheckboxField) name() string         { return f.Name }
+func (f CheckboxField) label() string        { return f.Label }
+func (f CheckboxField) initial() interface{} { return f.Default }
+
+// SubmittedMsg is dispatched when Enter is pressed on a valid last step.
+type SubmittedMsg struct {
+	Values map[string]interface{}
+}
+
+// CancelledMsg is dispatched when the user dismisses the form with Esc.
+type CancelledMsg struct{}
+
+// Form is an interactive multi-step form. Use View and Update to embed it in
+// an app, or Run for a blocking one-shot form.
+type Form struct {
+	steps     []Step
+	step      int
+	focus     *runetui.FocusManager
+	values    map[string]interface{}
+	errs      map[string]error
+	submitted bool
+}
+
+// New creates a Form with every field set to its default value.
+func New(steps ...Step) *Form {
+	f := &Form{
+		steps:  steps,
+		values: make(map[string]interface{}),
+		errs:   make(map[string]error),
+	}
+	for _, s := range steps {
+		for _, field := range s.Fields {
+			f.values[field.name()] = field.initial()
+		}
+	}
+	f.setStep(0)
+	return f
+}
+
+// setStep shows step i with focus on its first field.
+func (f *Form) setStep(i int) {
+	f.step = i
+	var names []string
+	if i < len(f.steps) {
+		for _, field := range f.steps[i].Fields {
+			names = append(names, field.name())
+		}
+	}
+	f.focus = runetui.NewFocusManager(names...)
+}
+
+// Run shows the form and blocks until it is submitted or cancelled. It
+// returns ErrCancelled when the form was not submitted.
+func (f *Form) Run() (map[string]interface{}, error) {
+	update := func(msg tea.Msg) tea.Cmd {
+		switch msg.(type) {
+		case SubmittedMsg, CancelledMsg:
+			return tea.Quit
+		}
+		return f.Update(msg)
+	}
+	app := runetui.New(f.View, runetui.WithUpdate(update), runetui.WithShortcuts(Shortcuts...))
+	if err := app.Run(); err != nil {
+		return nil, err
+	}
+	if !f.submitted {
+		return nil, ErrCancelled
+	}
+	return f.Values(), nil
+}
+
+// Values returns a copy of the current values keyed by field name.
+func (f *Form) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(f.values))
+	for k, v := range f.values {
+		values[k] = v
+	}
+	return values
+}
+
+// Step returns the index of the current step.
+func (f *Form) Step() int {
+	return f.step
+}
+
+// Focused returns the index of the focused field within the current step.
+func (f *Form) Focused() int {
+	for i, field := range f.steps[f.step].Fields {
+		if field.name() == f.focus.FocusedKey() {
+			return i
+		}
+	}
+	return 0
+}
+
+// Err returns the validation error of the named field, if any.
+func (f *Form) Err(name string) error {
+	return f.errs[name]
+}
+
+// Update handles navigation and editing keys. Enter validates the current
+// step and moves to the next one, or dispatches SubmittedMsg on the last step.
+// Esc dispatches CancelledMsg.
+func (f *Form) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || len(f.steps) == 0 {
+		return nil
+	}
+
+	if f.focus.HandleKey(key) {
+		return nil
+	}
+	switch key.String() {
+	case "down":
+		f.focus.Next()
+	case "up":
+		f.focus.Prev()
+	case "pgup":
+		if f.step > 0 {
+			f.setStep(f.step - 1)
+		}
+	case "enter":
+		return f.next()
+	case "esc":
+		return func() tea.Msg { return CancelledMsg{} }
+	default:
+		if field := f.focusedField(); field != nil {
+			f.edit(field, key)
+		}
+	}
+	return nil
+}
+
+// focusedField returns the field with focus, or nil for an empty step.
+func (f *Form) focusedField() Field {
+	for _, field := range f.steps[f.step].Fields {
+		if field.name() == f.focus.FocusedKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+// next validates the current step and advances or submits.
+func (f *Form) next() tea.Cmd {
+	if !f.validateStep() {
+		return nil
+	}
+	if f.step < len(f.steps)-1 {
+		f.setStep(f.step + 1)
+		return nil
+	}
+	f.submitted = true
+	values := f.Values()
+	return func() tea.Msg { return SubmittedMsg{Values: values} }
+}
+
+// validateStep records errors for the current step's fields and focuses the
+// first invalid one. It reports whether the step is valid.
+func (f *Form) validateStep() bool {
+	valid := true
+	for _, field := range f.steps[f.step].Fields {
+		err := f.validate(field)
+		if err == nil {
+			delete(f.errs, field.name())
+			continue
+		}
+		f.errs[field.name()] = err
+		if valid {
+			f.focus.SetFocus(field.name())
+		}
+		valid = false
+	}
+	return valid
+}
+
+// validate checks a single field's value.
+func (f *Form) validate(field Field) error {
+	switch field := field.(type) {
+	case TextField:
+		value := f.values[field.Name].(string)
+		if field.Required && strings.TrimSpace(value) == "" {
+			return ErrRequired
+		}
+		if field.Validate != nil {
+			return field.Validate(value)
+		}
+	case NumberField:
+		value := f.values[field.Name].(int)
+		if field.Max > field.Min && (value < field.Min || value > field.Max) {
+			return fmt.Errorf("must be between %d and %d", field.Min, field.Max)
+		}
+	}
+	return nil
+}
+
+// edit applies key to the focused field.
+func (f *Form) edit(field Field, key tea.KeyMsg) {
+	name := field.name()
+	switch field := field.(type) {
+	case TextField:
+		if cmd := runetui.HandleTextInputKey(f.textInputProps(field, true), key); cmd != nil {
+			f.values[name] = cmd().(runetui.TextInputChangeMsg).Value
+		}
+	case NumberField:
+		f.values[name] = editNumber(field, f.values[name].(int), key)
+	case SelectField:
+		if len(field.Options) == 0 {
+			return
+		}
+		index := indexOf(field.Options, f.values[name].(string))
+		switch key.String() {
+		case "left", "h":
+			index = (index + len(field.Options) - 1) % len(field.Options)
+		case "right", "l", " ":
+			index = (index + 1) % len(field.Options)
+		}
+		f.values[name] = field.Options[index]
+	case CheckboxField:
+		if key.String() == " " || key.String() == "x" {
+			f.values[name] = !f.values[name].(bool)
+		}
+	}
+	delete(f.errs, name)
+}
+
+// editNumber steps value with left/right, appends typed digits and removes
+// the last digit on backspace. Stepping is clamped to the field's range.
+func editNumber(field NumberField, value int, key tea.KeyMsg) int {
+	step := max(field.Step, 1)
+	clamp := func(v int) int {
+		if field.Max > field.Min {
+			return max(field.Min, min(v, field.Max))
+		}
+		return v
+	}
+	switch key.String() {
+	case "left", "h", "-":
+		return clamp(value - step)
+	case "right", "l", "+":
+		return clamp(value + step)
+	case "backspace":
+		return value / 10
+	}
+	if len(key.Runes) == 1 && key.Runes[0] >= '0' && key.Runes[0] <= '9' {
+		return value*10 + int(key.Runes[0]-'0')
+	}
+	return value
+}
+
+// indexOf returns the position of s in options, or 0 if it is absent.
+func indexOf(options []string, s string) int {
+	for i, option := range options {
+		if option == s {
+			return i
+		}
+	}
+	return 0
+}
+
+// View renders the current step: a title, one line per field and any
+// validation errors beneath their fields.
+func (f *Form) View() runetui.Component {
+	if len(f.steps) == 0 {
+		return runetui.Text("")
+	}
+	step := f.steps[f.step]
+	children := []runetui.Component{
+		runetui.Text(fmt.Sprintf("Step %d of %d: %s", f.step+1, len(f.steps), step.Title),
+			runetui.TextProps{Bold: true, Key: "title"}),
+	}
+	for _, field := range step.Fields {
+		children = append(children, f.renderField(field, field.name() == f.focus.FocusedKey()))
+		if err := f.errs[field.name()]; err != nil {
+			children = append(children, runetui.Text("  "+err.Error(),
+				runetui.TextProps{Color: "#FF5555", Key: field.name() + "-error"}))
+		}
+	}
+	return runetui.VStack(children...)
+}
+
+// renderField renders a field as its label followed by its input, marking
+// the focused one.
+func (f *Form) renderField(field Field, focused bool) runetui.Component {
+	props := runetui.TextProps{Prefix: "  "}
+	if focused {
+		props.Prefix = "> "
+		props.Bold = true
+	}
+	label := runetui.Text(field.label()+": ", props)
+
+	switch field := field.(type) {
+	case TextField:
+		return runetui.HStack(label, runetui.TextInput(f.textInputProps(field, focused)))
+	case NumberField:
+		return runetui.HStack(label, runetui.TextInput(runetui.TextInputProps{
+			Value:   strconv.Itoa(f.values[field.Name].(int)),
+			Focused: focused,
+			Key:     field.Name,
+		}))
+	case SelectField:
+		list := runetui.SelectList(runetui.SelectListProps{
+			SelectedIndex: indexOf(field.Options, f.values[field.Name].(string)),
+			SelectedColor: "#50FA7B",
+			Focused:       focused,
+			Key:           field.Name,
+		}, field.Options)
+		return runetui.VStack(label, runetui.HStack(runetui.Text("  "), list))
+	case CheckboxField:
+		value := "[ ]"
+		if f.values[field.Name].(bool) {
+			value = "[x]"
+		}
+		return runetui.HStack(label, runetui.Text(value, runetui.TextProps{Key: field.Name}))
+	}
+	return label
+}
+
+// textInputProps returns the TextInput props showing field.
+func (f *Form) textInputProps(field TextField, focused bool) runetui.TextInputProps {
+	return runetui.TextInputProps{
+		Value:       f.values[field.Name].(string),
+		Placeholder: field.Placeholder,
+		Focused:     focused,
+		MaxLength:   field.MaxLength,
+		Mask:        field.Mask,
+		Key:         field.Name,
+	}
+}
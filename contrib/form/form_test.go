@@ -0,0 +1,201 @@
+package form
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "pgup":
+		return tea.KeyMsg{Type: tea.KeyPgUp}
+	case "left":
+		return tea.KeyMsg{Type: tea.KeyLeft}
+	case "right":
+		return tea.KeyMsg{Type: tea.KeyRight}
+	case "backspace":
+		return tea.KeyMsg{Type: tea.KeyBackspace}
+	case " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func press(f *Form, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		cmd = f.Update(key(k))
+	}
+	return cmd
+}
+
+func newProjectForm() *Form {
+	return New(
+		Step{Title: "Project", Fields: []Field{
+			TextField{Name: "name", Label: "Name", Required: true},
+			SelectField{Name: "license", Label: "License", Options: []string{"MIT", "Apache-2.0", "BSD"}},
+		}},
+		Step{Title: "Options", Fields: []Field{
+			NumberField{Name: "workers", Label: "Workers", Min: 1, Max: 16, Default: 4},
+			CheckboxField{Name: "git", Label: "Initialize git", Default: true},
+		}},
+	)
+}
+
+func TestNew_ValuesStartAtDefaults(t *testing.T) {
+	values := newProjectForm().Values()
+
+	expected := map[string]interface{}{"name": "", "license": "MIT", "workers": 4, "git": true}
+	for name, want := range expected {
+		if values[name] != want {
+			t.Errorf("%s: expected %v, got %v", name, want, values[name])
+		}
+	}
+}
+
+func TestUpdate_FillsAndSubmitsAllSteps(t *testing.T) {
+	f := newProjectForm()
+
+	press(f, "a", "p", "p", "tab", "right")
+	press(f, "enter")
+	if f.Step() != 1 {
+		t.Fatalf("expected second step, got %d", f.Step())
+	}
+	press(f, "right", "right", "tab", " ")
+	cmd := press(f, "enter")
+
+	msg, ok := cmd().(SubmittedMsg)
+	if !ok {
+		t.Fatalf("expected SubmittedMsg, got %v", cmd())
+	}
+	expected := map[string]interface{}{"name": "app", "license": "Apache-2.0", "workers": 6, "git": false}
+	for name, want := range expected {
+		if msg.Values[name] != want {
+			t.Errorf("%s: expected %v, got %v", name, want, msg.Values[name])
+		}
+	}
+}
+
+func TestUpdate_RequiredField_BlocksNextStep(t *testing.T) {
+	f := newProjectForm()
+
+	press(f, "tab", "enter")
+
+	if f.Step() != 0 {
+		t.Errorf("expected to stay on the first step, got %d", f.Step())
+	}
+	if f.Focused() != 0 {
+		t.Errorf("expected focus on the invalid field, got %d", f.Focused())
+	}
+	if !errors.Is(f.Err("name"), ErrRequired) {
+		t.Errorf("expected ErrRequired, got %v", f.Err("name"))
+	}
+
+	press(f, "x")
+	if f.Err("name") != nil {
+		t.Errorf("expected editing to clear the error, got %v", f.Err("name"))
+	}
+}
+
+func TestUpdate_CustomValidate_ReportsError(t *testing.T) {
+	errShort := errors.New("too short")
+	f := New(Step{Fields: []Field{TextField{Name: "name", Validate: func(s string) error {
+		if len(s) < 3 {
+			return errShort
+		}
+		return nil
+	}}}})
+
+	if cmd := press(f, "a", "b", "enter"); cmd != nil {
+		t.Errorf("expected no submit for an invalid value")
+	}
+	if !errors.Is(f.Err("name"), errShort) {
+		t.Errorf("expected custom error, got %v", f.Err("name"))
+	}
+}
+
+func TestUpdate_TextField_UsesTextInputEditing(t *testing.T) {
+	f := New(Step{Title: "Login", Fields: []Field{
+		TextField{Name: "pin", Label: "PIN", MaxLength: 4, Mask: '*'},
+	}})
+	press(f, "1", " ", "2", "3", "4")
+
+	if got := f.Values()["pin"]; got != "1 23" {
+		t.Errorf("expected MaxLength to stop at %q, got %q", "1 23", got)
+	}
+	output := runetui.StripANSI(rtest.RenderToString(f.View, 40, 2))
+	runetui.AssertContainsText(t, output, "> PIN: ****")
+}
+
+func TestUpdate_NumberField_TypingAndRange(t *testing.T) {
+	f := New(Step{Fields: []Field{NumberField{Name: "n", Min: 1, Max: 16}}})
+
+	press(f, "backspace", "2", "0")
+	if f.Values()["n"] != 20 {
+		t.Fatalf("expected typed value 20, got %v", f.Values()["n"])
+	}
+	if cmd := press(f, "enter"); cmd != nil {
+		t.Errorf("expected out-of-range value to block submit")
+	}
+	press(f, "left")
+	if f.Values()["n"] != 16 {
+		t.Errorf("expected stepping to clamp to max, got %v", f.Values()["n"])
+	}
+}
+
+func TestUpdate_PgUp_ReturnsToPreviousStep(t *testing.T) {
+	f := newProjectForm()
+	press(f, "a", "enter")
+
+	press(f, "pgup")
+
+	if f.Step() != 0 || f.Values()["name"] != "a" {
+		t.Errorf("expected first step with values kept, got step %d values %v", f.Step(), f.Values())
+	}
+}
+
+func TestUpdate_Esc_DispatchesCancelled(t *testing.T) {
+	if msg := press(newProjectForm(), "esc")(); msg != (CancelledMsg{}) {
+		t.Errorf("expected CancelledMsg, got %v", msg)
+	}
+}
+
+func TestView_ShowsStepFieldsAndErrors(t *testing.T) {
+	f := newProjectForm()
+	press(f, "enter")
+
+	output := runetui.StripANSI(rtest.RenderToString(f.View, 40, 6))
+
+	runetui.AssertContainsText(t, output, "Step 1 of 2: Project")
+	runetui.AssertContainsText(t, output, "> Name: ")
+	runetui.AssertContainsText(t, output, "  required")
+	runetui.AssertContainsText(t, output, "  License:")
+	runetui.AssertContainsText(t, output, "    MIT")
+}
+
+func TestView_FocusedSelectField_MarksChosenOption(t *testing.T) {
+	f := newProjectForm()
+	press(f, "tab", "right")
+
+	output := runetui.StripANSI(rtest.RenderToString(f.View, 40, 6))
+
+	runetui.AssertContainsText(t, output, "> License:")
+	runetui.AssertContainsText(t, output, "  ▶ Apache-2.0")
+}
+
+func TestView_Snapshot(t *testing.T) {
+	f := newProjectForm()
+	press(f, "a", "enter")
+
+	rtest.AssertSnapshot(t, "form_options_step", rtest.RenderToString(f.View, 40, 4))
+}
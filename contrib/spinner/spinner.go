@@ -0,0 +1,201 @@
+// Package spinner provides SpinnerGroup, a set of concurrent spinners for
+// parallel tasks such as build steps or downloads.
+//
+// Each spinner animates on its own tick until it is completed, at which point
+// it is replaced with ✓ or ✗. The group batches the tick commands of all
+// running spinners so they can be returned from a single update.
+//
+// Example usage:
+//
+//	group := spinner.New()
+//	group.Add("api", "Compiling api")
+//	group.Add("web", "Compiling web")
+//
+//	app := runetui.New(group.Render,
+//	    runetui.WithInit(group.Tick),
+//	    runetui.WithUpdate(func(msg tea.Msg) tea.Cmd {
+//	        if done, ok := msg.(buildDoneMsg); ok {
+//	            group.Complete(done.target, done.err == nil)
+//	        }
+//	        return group.Update(msg)
+//	    }),
+//	)
+package spinner
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// DefaultFrames are the animation frames used when SpinnerGroup.Frames is empty.
+var DefaultFrames = runetui.SpinnerDots
+
+// DefaultInterval is the time between frames when SpinnerGroup.Interval is zero.
+const DefaultInterval = 100 * time.Millisecond
+
+// TickMsg advances the spinner with Key by one frame.
+type TickMsg struct {
+	Key string
+}
+
+// Spinner is a single labelled spinner in a SpinnerGroup.
+type Spinner struct {
+	group   *SpinnerGroup
+	key     string
+	label   string
+	frame   int
+	ticking bool
+	done    bool
+	success bool
+}
+
+// Key returns the spinner's key.
+func (s *Spinner) Key() string {
+	return s.key
+}
+
+// Done reports whether the spinner has been completed.
+func (s *Spinner) Done() bool {
+	return s.done
+}
+
+// Success reports whether the spinner was completed successfully.
+func (s *Spinner) Success() bool {
+	return s.success
+}
+
+// Tick returns the command that advances this spinner by one frame. It
+// returns nil once the spinner is done, or while a tick is already pending, so
+// each spinner has at most one tick in flight.
+func (s *Spinner) Tick() tea.Cmd {
+	if s.done || s.ticking {
+		return nil
+	}
+	s.ticking = true
+	key := s.key
+	return tea.Tick(s.group.interval(), func(time.Time) tea.Msg {
+		return TickMsg{Key: key}
+	})
+}
+
+// SpinnerGroup renders a list of spinners, one per line, in the order they
+// were added.
+type SpinnerGroup struct {
+	// Frames are the animation frames. DefaultFrames is used when empty.
+	Frames []string
+	// Interval is the time between frames. DefaultInterval is used when zero.
+	Interval time.Duration
+
+	spinners []*Spinner
+	byKey    map[string]*Spinner
+}
+
+// New creates an empty SpinnerGroup.
+func New() *SpinnerGroup {
+	return &SpinnerGroup{byKey: make(map[string]*Spinner)}
+}
+
+// Add adds a running spinner. Adding an existing key updates its label and
+// returns the existing spinner. Start it with its Tick or the group's Tick.
+func (g *SpinnerGroup) Add(key, label string) *Spinner {
+	if s, ok := g.byKey[key]; ok {
+		s.label = label
+		return s
+	}
+	s := &Spinner{group: g, key: key, label: label}
+	g.spinners = append(g.spinners, s)
+	g.byKey[key] = s
+	return s
+}
+
+// Get returns the spinner with key, or nil if there is none.
+func (g *SpinnerGroup) Get(key string) *Spinner {
+	return g.byKey[key]
+}
+
+// Complete stops the spinner with key and marks it as succeeded or failed.
+// Unknown keys are ignored.
+func (g *SpinnerGroup) Complete(key string, success bool) {
+	if s, ok := g.byKey[key]; ok {
+		s.done, s.success = true, success
+	}
+}
+
+// Running returns the number of spinners that are not yet completed.
+func (g *SpinnerGroup) Running() int {
+	running := 0
+	for _, s := range g.spinners {
+		if !s.done {
+			running++
+		}
+	}
+	return running
+}
+
+// Tick batches the tick commands of every running spinner.
+func (g *SpinnerGroup) Tick() tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(g.spinners))
+	for _, s := range g.spinners {
+		cmds = append(cmds, s.Tick())
+	}
+	return tea.Batch(cmds...)
+}
+
+// Update advances the spinner named by a TickMsg and returns its next tick.
+// Other messages are ignored.
+func (g *SpinnerGroup) Update(msg tea.Msg) tea.Cmd {
+	tick, ok := msg.(TickMsg)
+	if !ok {
+		return nil
+	}
+	s, ok := g.byKey[tick.Key]
+	if !ok {
+		return nil
+	}
+	s.ticking = false
+	if s.done {
+		return nil
+	}
+	s.frame = (s.frame + 1) % len(g.frames())
+	return s.Tick()
+}
+
+// Render returns the spinners as a column, with completed ones shown as a
+// green ✓ or a red ✗.
+func (g *SpinnerGroup) Render() runetui.Component {
+	frames := g.frames()
+	lines := make([]runetui.Component, 0, len(g.spinners))
+	for _, s := range g.spinners {
+		icon, color := frames[s.frame%len(frames)], ""
+		if s.done {
+			icon, color = "✗", "#FF5555"
+			if s.success {
+				icon, color = "✓", "#50FA7B"
+			}
+		}
+		// The icon gets a fixed column so labels line up whatever the
+		// byte length of the frame.
+		lines = append(lines, runetui.HStack(
+			runetui.Box(runetui.BoxProps{Width: runetui.DimensionFixed(2)},
+				runetui.Text(icon, runetui.TextProps{Color: color})),
+			runetui.Text(s.label, runetui.TextProps{Key: s.key}),
+		))
+	}
+	return runetui.VStack(lines...)
+}
+
+func (g *SpinnerGroup) frames() []string {
+	if len(g.Frames) == 0 {
+		return DefaultFrames
+	}
+	return g.Frames
+}
+
+func (g *SpinnerGroup) interval() time.Duration {
+	if g.Interval <= 0 {
+		return DefaultInterval
+	}
+	return g.Interval
+}
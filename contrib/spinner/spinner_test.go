@@ -0,0 +1,110 @@
+package spinner
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func TestAdd_ExistingKey_ReturnsSameSpinner(t *testing.T) {
+	g := New()
+
+	first := g.Add("api", "Compiling api")
+	second := g.Add("api", "Linking api")
+
+	if first != second {
+		t.Errorf("expected the same spinner for a repeated key")
+	}
+	output := runetui.StripANSI(rtest.RenderToString(g.Render, 30, 2))
+	runetui.AssertContainsText(t, output, "Linking api")
+}
+
+func TestTick_OnePendingTickPerSpinner(t *testing.T) {
+	g := New()
+	s := g.Add("api", "Compiling api")
+
+	if s.Tick() == nil {
+		t.Fatal("expected a tick command for a new spinner")
+	}
+	if s.Tick() != nil {
+		t.Error("expected no second tick while one is pending")
+	}
+}
+
+func TestUpdate_TickMsg_AdvancesFrameAndReschedules(t *testing.T) {
+	g := New()
+	g.Frames = []string{"a", "b"}
+	g.Interval = time.Millisecond
+	s := g.Add("api", "api")
+	s.Tick()
+
+	cmd := g.Update(TickMsg{Key: "api"})
+
+	if cmd == nil {
+		t.Fatal("expected the next tick")
+	}
+	if msg := cmd(); msg != (TickMsg{Key: "api"}) {
+		t.Errorf("expected TickMsg for api, got %v", msg)
+	}
+	output := runetui.StripANSI(rtest.RenderToString(g.Render, 10, 1))
+	runetui.AssertContainsText(t, output, "b api")
+}
+
+func TestUpdate_CompletedSpinner_StopsTicking(t *testing.T) {
+	g := New()
+	g.Add("api", "api").Tick()
+	g.Complete("api", true)
+
+	if cmd := g.Update(TickMsg{Key: "api"}); cmd != nil {
+		t.Error("expected no tick after completion")
+	}
+	if cmd := g.Get("api").Tick(); cmd != nil {
+		t.Error("expected a completed spinner to have no tick")
+	}
+}
+
+func TestUpdate_IgnoresUnknownKeysAndMessages(t *testing.T) {
+	g := New()
+	g.Add("api", "api")
+
+	if g.Update(TickMsg{Key: "web"}) != nil || g.Update(tea.KeyMsg{}) != nil {
+		t.Error("expected unrelated messages to be ignored")
+	}
+}
+
+func TestTick_BatchesRunningSpinners(t *testing.T) {
+	g := New()
+	g.Interval = time.Millisecond
+	g.Add("api", "api")
+	g.Add("web", "web")
+	g.Add("cli", "cli")
+	g.Complete("cli", false)
+
+	msg := g.Tick()()
+
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("expected a batch of 2 ticks, got %v", msg)
+	}
+	if g.Running() != 2 {
+		t.Errorf("expected 2 running spinners, got %d", g.Running())
+	}
+}
+
+func TestRender_CompletedSpinners_ShowResultIcons(t *testing.T) {
+	g := New()
+	g.Add("api", "Compiling api")
+	g.Add("web", "Compiling web")
+	g.Add("cli", "Compiling cli")
+	g.Complete("api", true)
+	g.Complete("web", false)
+
+	output := runetui.StripANSI(rtest.RenderToString(g.Render, 30, 3))
+
+	runetui.AssertContainsText(t, output, "✓ Compiling api")
+	runetui.AssertContainsText(t, output, "✗ Compiling web")
+	runetui.AssertContainsText(t, output, DefaultFrames[0]+" Compiling cli")
+}
@@ -0,0 +1,44 @@
+package runetui
+
+import "fmt"
+
+// findCursor returns the absolute zero-based position of the first box in
+// depth-first order with a visible Cursor, and whether one was found.
+func findCursor(tree *LayoutTree) (row, col int, ok bool) {
+	if tree == nil {
+		return 0, 0, false
+	}
+	if b, isBox := tree.Component.(*box); isBox && b.props.Cursor.Visible {
+		return tree.Layout.Y + b.props.Cursor.Row, tree.Layout.X + b.props.Cursor.Col, true
+	}
+	for _, child := range tree.Children {
+		if row, col, ok := findCursor(child); ok {
+			return row, col, true
+		}
+	}
+	return 0, 0, false
+}
+
+// cursorSequence returns the CSI H escape sequence that moves the terminal
+// cursor to the tree's cursor position, shifted down by offsetRows lines of
+// content rendered above the tree. It returns "" when no box sets a cursor.
+// The sequence is absolute, so it is only correct when the frame starts at the
+// top of the screen, as it does on the alternate screen.
+func cursorSequence(tree *LayoutTree, offsetRows int) string {
+	row, col, ok := findCursor(tree)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[%d;%dH", offsetRows+row+1, col+1)
+}
+
+// frameCursor returns the sequence that places the terminal cursor for a
+// frame whose tree starts offsetRows lines down. Inline frames start wherever
+// the prompt was, and Bubble Tea repaints them relative to where it left the
+// cursor, so the cursor is only placed when running on the alternate screen.
+func (a *App) frameCursor(tree *LayoutTree, offsetRows int) string {
+	if !a.altScreen {
+		return ""
+	}
+	return cursorSequence(tree, offsetRows)
+}
@@ -0,0 +1,97 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindCursor_PositionWithoutVisible_ReturnsFalse(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Cursor: CursorPosition{Row: 1, Col: 2}}, Text("x")))
+
+	if _, _, ok := findCursor(tree); ok {
+		t.Error("expected no cursor unless Visible is set")
+	}
+}
+
+func TestFindCursor_VisibleAtOrigin_ReturnsTopLeft(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Cursor: CursorPosition{Visible: true}}, Text("x")))
+
+	row, col, ok := findCursor(tree)
+
+	if !ok || row != 0 || col != 0 {
+		t.Errorf("expected cursor at 0,0, got %d,%d (found %v)", row, col, ok)
+	}
+}
+
+func TestFindCursor_NoCursor_ReturnsFalse(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{}, Text("x")))
+
+	if _, _, ok := findCursor(tree); ok {
+		t.Error("expected no cursor")
+	}
+}
+
+func TestFindCursor_NestedBox_OffsetsByLayout(t *testing.T) {
+	root := Box(BoxProps{},
+		Text("header"),
+		Box(BoxProps{Cursor: CursorPosition{Row: 0, Col: 3, Visible: true}}, Text("input")),
+	)
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+
+	row, col, ok := findCursor(tree)
+
+	if !ok || row != 1 || col != 3 {
+		t.Errorf("expected cursor at 1,3, got %d,%d (found %v)", row, col, ok)
+	}
+}
+
+func TestCursorSequence_UsesOneBasedCoordinates(t *testing.T) {
+	tree := &LayoutTree{
+		Component: Box(BoxProps{Cursor: CursorPosition{Row: 1, Col: 2, Visible: true}}),
+		Layout:    Layout{X: 4, Y: 5},
+	}
+
+	if seq := cursorSequence(tree, 3); seq != "\x1b[10;7H" {
+		t.Errorf("expected %q, got %q", "\x1b[10;7H", seq)
+	}
+	if seq := cursorSequence(nil, 0); seq != "" {
+		t.Errorf("expected empty sequence, got %q", seq)
+	}
+}
+
+func TestModel_View_WithCursor_AppendsPositionSequence(t *testing.T) {
+	app := New(func() Component {
+		return Box(BoxProps{Cursor: CursorPosition{Col: 2, Visible: true}}, Text("abc"))
+	}, WithAltScreen())
+
+	output := app.createModel().View()
+
+	if !strings.HasSuffix(output, "\x1b[1;3H") {
+		t.Errorf("expected cursor sequence at end, got %q", output)
+	}
+}
+
+func TestModel_View_WithCursorAndStatic_OffsetsByStaticLines(t *testing.T) {
+	app := New(func() Component {
+		return Box(BoxProps{Cursor: CursorPosition{Col: 1, Visible: true}}, Text("abc"))
+	}, WithAltScreen())
+	app.staticManager.Append("one", "two")
+
+	output := app.createModel().View()
+
+	if !strings.HasSuffix(output, "\x1b[3;2H") {
+		t.Errorf("expected cursor below static lines, got %q", output)
+	}
+}
+
+func TestModel_View_InlineWithCursor_OmitsPositionSequence(t *testing.T) {
+	app := New(func() Component {
+		return Box(BoxProps{Cursor: CursorPosition{Col: 2, Visible: true}}, Text("abc"))
+	})
+
+	output := app.createModel().View()
+
+	if strings.Contains(output, "\x1b[") {
+		t.Errorf("expected no cursor sequence for inline rendering, got %q", output)
+	}
+}
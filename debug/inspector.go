@@ -0,0 +1,105 @@
+// Package debug provides development tools for inspecting RuneTUI apps.
+//
+// Inspector wraps an app with a layout inspector that can be toggled with
+// ctrl+d. When open, the screen is split in two: the real UI on the left and
+// an outline of its layout tree on the right.
+//
+// Example usage:
+//
+//	app := runetui.New(rootFunc, runetui.WithUpdate(updateFunc))
+//	if err := debug.Inspector(app).Run(); err != nil {
+//	    log.Fatal(err)
+//	}
+package debug
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// ToggleKey is the key that opens and closes the inspector pane.
+const ToggleKey = "ctrl+d"
+
+// inspector holds the state of the layout inspector overlay.
+type inspector struct {
+	root   runetui.ComponentFunc
+	update runetui.UpdateFunc
+	open   bool
+	width  int
+	height int
+}
+
+// Inspector returns a new app that renders app's component tree with a
+// toggleable layout inspector. The wrapped app's Init and Update functions are
+// preserved; any other options must be passed again through opts.
+func Inspector(app *runetui.App, opts ...runetui.AppOption) *runetui.App {
+	ins := &inspector{
+		root:   app.RootFunc(),
+		update: app.UpdateFunc(),
+		width:  80,
+		height: 24,
+	}
+	options := []runetui.AppOption{runetui.WithUpdate(ins.Update)}
+	if initFunc := app.InitFunc(); initFunc != nil {
+		options = append(options, runetui.WithInit(initFunc))
+	}
+	return runetui.New(ins.View, append(options, opts...)...)
+}
+
+// Update toggles the inspector on ToggleKey and forwards every other message.
+func (i *inspector) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == ToggleKey {
+			i.open = !i.open
+			return nil
+		}
+	case tea.WindowSizeMsg:
+		i.width = msg.Width
+		i.height = msg.Height
+	}
+	if i.update == nil {
+		return nil
+	}
+	return i.update(msg)
+}
+
+// View renders the app, split next to its layout outline when the inspector is open.
+func (i *inspector) View() runetui.Component {
+	if !i.open {
+		return i.root()
+	}
+
+	appWidth := i.width / 2
+	root := i.root()
+	tree := runetui.NewLayoutEngine(appWidth, i.height).CalculateLayout(root)
+
+	return runetui.HStack(
+		runetui.Box(runetui.BoxProps{
+			Key:      "inspector-app",
+			Width:    runetui.DimensionFixed(appWidth),
+			Height:   runetui.DimensionFixed(i.height),
+			Overflow: runetui.OverflowHidden,
+		}, root),
+		runetui.Box(runetui.BoxProps{
+			Key:       "inspector-tree",
+			Direction: runetui.Column,
+			Width:     runetui.DimensionFixed(i.width - appWidth),
+			Height:    runetui.DimensionFixed(i.height),
+			Border:    runetui.BorderSingle,
+			Overflow:  runetui.OverflowHidden,
+		}, outline(tree)...),
+	)
+}
+
+// outline converts the layout tree outline into one Text component per line.
+func outline(tree *runetui.LayoutTree) []runetui.Component {
+	lines := strings.Split(tree.String(), "\n")
+	children := make([]runetui.Component, len(lines))
+	for n, line := range lines {
+		children[n] = runetui.Text(line, runetui.TextProps{Color: "#888888"})
+	}
+	return children
+}
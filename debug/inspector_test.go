@@ -0,0 +1,102 @@
+package debug
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func newInspector(update runetui.UpdateFunc) *inspector {
+	root := func() runetui.Component {
+		return runetui.VStack(runetui.Text("Hello", runetui.TextProps{Key: "greeting"}))
+	}
+	return &inspector{root: root, update: update, width: 60, height: 10}
+}
+
+func TestInspector_Closed_RendersOnlyApp(t *testing.T) {
+	ins := newInspector(nil)
+
+	output := rtest.RenderToString(ins.View, 60, 10)
+
+	runetui.AssertContainsText(t, output, "Hello")
+	if runetui.StripANSI(output) != runetui.StripANSI(rtest.RenderToString(ins.root, 60, 10)) {
+		t.Errorf("expected closed inspector to render the app unchanged, got %q", output)
+	}
+}
+
+func TestInspector_ToggleKey_OpensAndClosesPane(t *testing.T) {
+	ins := newInspector(nil)
+	ctrlD := tea.KeyMsg{Type: tea.KeyCtrlD}
+
+	ins.Update(ctrlD)
+	if !ins.open {
+		t.Fatal("expected inspector to open on ctrl+d")
+	}
+	ins.Update(ctrlD)
+	if ins.open {
+		t.Error("expected inspector to close on second ctrl+d")
+	}
+}
+
+func TestInspector_Open_ShowsLayoutOutline(t *testing.T) {
+	ins := newInspector(nil)
+	ins.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+
+	output := rtest.RenderToString(ins.View, 60, 10)
+
+	runetui.AssertContainsText(t, output, "Hello")
+	runetui.AssertContainsText(t, output, "[greeting] 0,0 5x1")
+}
+
+func TestInspector_Open_SplitsWidthInHalf(t *testing.T) {
+	ins := newInspector(nil)
+	ins.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+	ins.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+
+	tree := runetui.NewLayoutEngine(100, 20).CalculateLayout(ins.View())
+
+	if len(tree.Children) != 2 {
+		t.Fatalf("expected two panes, got %d", len(tree.Children))
+	}
+	if tree.Children[0].Layout.Width != 50 || tree.Children[1].Layout.Width != 50 {
+		t.Errorf("expected 50/50 split, got %d/%d", tree.Children[0].Layout.Width, tree.Children[1].Layout.Width)
+	}
+}
+
+func TestInspector_ForwardsOtherMessagesToWrappedUpdate(t *testing.T) {
+	var received []tea.Msg
+	ins := newInspector(func(msg tea.Msg) tea.Cmd {
+		received = append(received, msg)
+		return nil
+	})
+
+	ins.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	ins.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	ins.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+
+	if len(received) != 2 {
+		t.Fatalf("expected 2 forwarded messages, got %d", len(received))
+	}
+	if ins.width != 90 || ins.height != 30 {
+		t.Errorf("expected inspector to track size 90x30, got %dx%d", ins.width, ins.height)
+	}
+}
+
+func TestInspector_WrapsAppRootAndKeepsInit(t *testing.T) {
+	initCalled := false
+	app := runetui.New(
+		func() runetui.Component { return runetui.Text("Wrapped") },
+		runetui.WithInit(func() tea.Cmd { initCalled = true; return nil }),
+	)
+
+	wrapped := Inspector(app)
+	wrapped.InitFunc()()
+	output := rtest.RenderToString(wrapped.RootFunc(), 80, 24)
+
+	if !initCalled {
+		t.Error("expected wrapped app to keep the init function")
+	}
+	runetui.AssertContainsText(t, output, "Wrapped")
+}
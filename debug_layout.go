@@ -0,0 +1,32 @@
+package runetui
+
+import "github.com/charmbracelet/lipgloss"
+
+// debugColors is the border color rotation used by WithDebugLayout, by depth.
+var debugColors = []string{"#FF0000", "#0000FF", "#00FF00", "#FFFF00"}
+
+// debugComponent wraps a component and draws a colored border around its output.
+type debugComponent struct {
+	Component
+	depth int
+}
+
+// Render renders the wrapped component inside a border colored by depth.
+func (d *debugComponent) Render(layout Layout) string {
+	color := debugColors[d.depth%len(debugColors)]
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color(color))
+	return style.Render(d.Component.Render(layout))
+}
+
+// applyDebugLayout wraps every node of the tree in a debugComponent.
+func applyDebugLayout(tree *LayoutTree, depth int) {
+	if tree == nil {
+		return
+	}
+	tree.Component = &debugComponent{Component: tree.Component, depth: depth}
+	for _, child := range tree.Children {
+		applyDebugLayout(child, depth+1)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !debug
+
+package runetui
+
+// WithDebugLayout is a no-op unless the program is built with the debug tag
+// (go build -tags debug), so it can be left in production code safely.
+func WithDebugLayout() AppOption {
+	return func(a *App) {}
+}
@@ -0,0 +1,13 @@
+//go:build !debug
+
+package runetui
+
+import "testing"
+
+func TestWithDebugLayout_WithoutDebugTag_IsNoOp(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithDebugLayout())
+
+	if app.debugLayout {
+		t.Error("expected debug layout to stay disabled without the debug build tag")
+	}
+}
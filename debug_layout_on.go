@@ -0,0 +1,12 @@
+//go:build debug
+
+package runetui
+
+// WithDebugLayout draws a colored border around every component after layout,
+// rotating red, blue, green and yellow by tree depth.
+// It is only active in builds with the debug tag (go build -tags debug).
+func WithDebugLayout() AppOption {
+	return func(a *App) {
+		a.debugLayout = true
+	}
+}
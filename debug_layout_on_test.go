@@ -0,0 +1,13 @@
+//go:build debug
+
+package runetui
+
+import "testing"
+
+func TestWithDebugLayout_WithDebugTag_EnablesOverlay(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithDebugLayout())
+
+	if !app.debugLayout {
+		t.Error("expected debug layout to be enabled with the debug build tag")
+	}
+}
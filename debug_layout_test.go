@@ -0,0 +1,84 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyDebugLayout_WrapsEveryNodeWithDepth(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	tree := engine.CalculateLayout(VStack(Text("a"), HStack(Text("b"))))
+
+	applyDebugLayout(tree, 0)
+
+	depths := []int{}
+	var walk func(node *LayoutTree)
+	walk = func(node *LayoutTree) {
+		d, ok := node.Component.(*debugComponent)
+		if !ok {
+			t.Fatalf("expected debugComponent, got %T", node.Component)
+		}
+		depths = append(depths, d.depth)
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(tree)
+
+	if got := len(depths); got != 4 {
+		t.Fatalf("expected 4 nodes, got %d", got)
+	}
+	if depths[0] != 0 || depths[1] != 1 || depths[2] != 1 || depths[3] != 2 {
+		t.Errorf("expected depths [0 1 1 2], got %v", depths)
+	}
+}
+
+func TestDebugComponent_Render_UsesColorForDepth(t *testing.T) {
+	tests := map[int]string{
+		0: "38;2;255;0;0",
+		1: "38;2;0;0;255",
+		2: "38;2;0;255;0",
+		3: "38;2;255;255;0",
+		4: "38;2;255;0;0",
+	}
+	for depth, color := range tests {
+		d := &debugComponent{Component: Text("x"), depth: depth}
+
+		output := d.Render(Layout{Width: 1, Height: 1})
+
+		if !strings.Contains(output, color) {
+			t.Errorf("depth %d: expected color %s in %q", depth, color, output)
+		}
+		if StripANSI(output) != "┌─┐\n│x│\n└─┘" {
+			t.Errorf("depth %d: expected bordered output, got %q", depth, StripANSI(output))
+		}
+	}
+}
+
+func TestDebugComponent_DelegatesKeyAndChildren(t *testing.T) {
+	inner := Box(BoxProps{Key: "panel"}, Text("a"))
+	d := &debugComponent{Component: inner}
+
+	if d.Key() != "panel" {
+		t.Errorf("expected key 'panel', got %q", d.Key())
+	}
+	if len(d.Children()) != 1 {
+		t.Errorf("expected 1 child, got %d", len(d.Children()))
+	}
+}
+
+func TestApplyDebugLayout_NilTree_DoesNotPanic(t *testing.T) {
+	applyDebugLayout(nil, 0)
+}
+
+func TestModel_View_WithDebugLayoutField_DrawsBorders(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+	app.debugLayout = true
+	m := app.createModel()
+
+	output := StripANSI(m.View())
+
+	if output != "┌─────┐\n│Hello│\n└─────┘" {
+		t.Errorf("expected bordered output, got %q", output)
+	}
+}
@@ -0,0 +1,68 @@
+package runetui
+
+// DirtyChecker is an optional extension of Component.
+// Components that implement it report whether their output may have changed
+// since the last render, letting renderTree reuse the previous output.
+type DirtyChecker interface {
+	Dirty() bool
+}
+
+// renderMemoizer is implemented by components that remember their last output.
+type renderMemoizer interface {
+	lastRender(layout Layout) (string, bool)
+}
+
+// renderMemo remembers the props and layout of a component's last render,
+// along with the app-wide no-color setting and theme it was rendered under.
+type renderMemo[P comparable] struct {
+	rendered bool
+	props    P
+	layout   Layout
+	noColor  bool
+	theme    Theme
+	output   string
+}
+
+// dirty reports whether props, the no-color setting or the theme differ from
+// the last render.
+func (m *renderMemo[P]) dirty(props P) bool {
+	return !m.rendered || m.props != props || m.noColor != noColorRender || m.theme != CurrentTheme()
+}
+
+// lookup returns the last output if it was rendered with the given layout.
+func (m *renderMemo[P]) lookup(layout Layout) (string, bool) {
+	if !m.rendered || m.layout != layout {
+		return "", false
+	}
+	return m.output, true
+}
+
+// store records the output of a render.
+func (m *renderMemo[P]) store(props P, layout Layout, output string) {
+	m.rendered = true
+	m.props = props
+	m.layout = layout
+	m.noColor = noColorRender
+	m.theme = CurrentTheme()
+	m.output = output
+}
+
+// isDirty reports whether a component must be rendered again.
+// Components that do not implement DirtyChecker are always dirty.
+func isDirty(c Component) bool {
+	d, ok := c.(DirtyChecker)
+	return !ok || d.Dirty()
+}
+
+// cachedRender returns the component's previous output when it is clean and
+// was last rendered with the same layout.
+func cachedRender(c Component, layout Layout) (string, bool) {
+	if isDirty(c) {
+		return "", false
+	}
+	m, ok := c.(renderMemoizer)
+	if !ok {
+		return "", false
+	}
+	return m.lastRender(layout)
+}
@@ -0,0 +1,137 @@
+package runetui
+
+import "testing"
+
+type memoComponent struct {
+	renders int
+	dirty   bool
+	output  string
+}
+
+func (c *memoComponent) Render(layout Layout) string {
+	c.renders++
+	return c.output
+}
+func (c *memoComponent) Children() []Component { return []Component{} }
+func (c *memoComponent) Key() string           { return "" }
+func (c *memoComponent) Measure(availableWidth, availableHeight int) Size {
+	return Size{Width: len(c.output), Height: 1}
+}
+func (c *memoComponent) Dirty() bool { return c.dirty }
+func (c *memoComponent) lastRender(layout Layout) (string, bool) {
+	return "cached", true
+}
+
+func TestText_Dirty_BeforeFirstRender_ReturnsTrue(t *testing.T) {
+	component := Text("Hello")
+
+	if !component.(DirtyChecker).Dirty() {
+		t.Error("expected text to be dirty before first render")
+	}
+}
+
+func TestText_Dirty_AfterRender_ReturnsFalse(t *testing.T) {
+	component := Text("Hello")
+	component.Render(Layout{Width: 5, Height: 1})
+
+	if component.(DirtyChecker).Dirty() {
+		t.Error("expected text to be clean after render")
+	}
+}
+
+func TestText_Dirty_AfterPropsChange_ReturnsTrue(t *testing.T) {
+	component := Text("Hello").(*text)
+	component.Render(Layout{Width: 5, Height: 1})
+
+	component.props.Bold = true
+
+	if !component.Dirty() {
+		t.Error("expected text to be dirty after props change")
+	}
+}
+
+func TestText_Dirty_AfterNoColorChange_ReturnsTrue(t *testing.T) {
+	component := Text("Hello", TextProps{Color: "#FF0000"})
+	component.Render(Layout{Width: 5, Height: 1})
+
+	setNoColor(true)
+	defer setNoColor(false)
+
+	if !component.(DirtyChecker).Dirty() {
+		t.Error("expected text to be dirty after the no-color setting changed")
+	}
+}
+
+func TestBox_Dirty_AfterThemeChange_ReturnsTrue(t *testing.T) {
+	component := Box(BoxProps{}, Text("a"))
+	component.Render(Layout{Width: 1, Height: 1})
+
+	theme := DefaultTheme()
+	theme.Primary = "#000000"
+	setTheme(&theme)
+	defer setTheme(nil)
+
+	if !component.(DirtyChecker).Dirty() {
+		t.Error("expected box to be dirty after the theme changed")
+	}
+}
+
+func TestBox_Dirty_AfterRenderWithCleanChildren_ReturnsFalse(t *testing.T) {
+	component := Box(BoxProps{}, Text("a"), Text("b"))
+	component.Render(Layout{Width: 5, Height: 2})
+
+	if component.(DirtyChecker).Dirty() {
+		t.Error("expected box to be clean after render")
+	}
+}
+
+func TestBox_Dirty_WithComponentFuncChild_ReturnsTrue(t *testing.T) {
+	child := ComponentFunc(func() Component { return Text("a") })
+	component := Box(BoxProps{}, child)
+	component.Render(Layout{Width: 5, Height: 1})
+
+	if !component.(DirtyChecker).Dirty() {
+		t.Error("expected box with non-tracking child to stay dirty")
+	}
+}
+
+func TestCachedRender_WithDifferentLayout_Misses(t *testing.T) {
+	component := Text("Hello")
+	component.Render(Layout{Width: 5, Height: 1})
+
+	if _, ok := cachedRender(component, Layout{Width: 10, Height: 1}); ok {
+		t.Error("expected cache miss for a different layout")
+	}
+	output, ok := cachedRender(component, Layout{Width: 5, Height: 1})
+	if !ok || output != "Hello" {
+		t.Errorf("expected cached 'Hello', got %q (hit=%v)", output, ok)
+	}
+}
+
+func TestRenderTree_CleanComponent_SkipsRender(t *testing.T) {
+	component := &memoComponent{output: "fresh"}
+	tree := &LayoutTree{Component: component, Layout: Layout{Width: 5, Height: 1}}
+
+	output := renderTree(tree)
+
+	if component.renders != 0 {
+		t.Errorf("expected Render to be skipped, got %d calls", component.renders)
+	}
+	if output != "cached" {
+		t.Errorf("expected cached output, got %q", output)
+	}
+}
+
+func TestRenderTree_DirtyComponent_CallsRender(t *testing.T) {
+	component := &memoComponent{output: "fresh", dirty: true}
+	tree := &LayoutTree{Component: component, Layout: Layout{Width: 5, Height: 1}}
+
+	output := renderTree(tree)
+
+	if component.renders != 1 {
+		t.Errorf("expected 1 Render call, got %d", component.renders)
+	}
+	if output != "fresh" {
+		t.Errorf("expected fresh output, got %q", output)
+	}
+}
@@ -1,6 +1,7 @@
 // Async example demonstrates asynchronous operations with RuneTUI.
 // This example shows how to use WithInit for initial commands and
 // handle async responses following the Elm Architecture pattern.
+// The result is shown in the theme's success or error color.
 package main
 
 import (
@@ -37,6 +38,7 @@ func main() {
 	app := runetui.New(rootFunc,
 		runetui.WithInit(initFunc),
 		runetui.WithUpdate(updateFunc),
+		runetui.WithTheme(runetui.DarkTheme()),
 	)
 	if err := app.Run(); err != nil {
 		log.Fatal(err)
@@ -46,34 +48,36 @@ func main() {
 // createAsyncApp creates an async loading application.
 func createAsyncApp(state *asyncState) (runetui.ComponentFunc, runetui.UpdateFunc) {
 	rootFunc := func() runetui.Component {
+		theme := runetui.CurrentTheme()
 		var content runetui.Component
 
 		if state.loading {
 			spinner := spinnerFrames[state.frame%len(spinnerFrames)]
-			content = runetui.Text(spinner + " Loading...")
+			content = runetui.Text(spinner+" Loading...", runetui.TextProps{Color: theme.Secondary})
 		} else if state.err != "" {
 			content = runetui.VStack(
-				runetui.Text("Error!", runetui.TextProps{Bold: true}),
-				runetui.Text(state.err),
+				runetui.Text("Error!", runetui.TextProps{Bold: true, Color: theme.Error}),
+				runetui.Text(state.err, runetui.TextProps{Color: theme.Text}),
 			)
 		} else {
 			content = runetui.VStack(
-				runetui.Text("Data Loaded!", runetui.TextProps{Bold: true}),
-				runetui.Text(state.data),
+				runetui.Text("Data Loaded!", runetui.TextProps{Bold: true, Color: theme.Success}),
+				runetui.Text(state.data, runetui.TextProps{Color: theme.Text}),
 			)
 		}
 
 		return runetui.Box(
 			runetui.BoxProps{
-				Direction: runetui.Column,
-				Border:    runetui.BorderSingle,
-				Padding:   runetui.SpacingAll(1),
+				Direction:   runetui.Column,
+				Border:      runetui.BorderSingle,
+				BorderColor: theme.Primary,
+				Padding:     runetui.SpacingAll(1),
 			},
-			runetui.Text("Async Example", runetui.TextProps{Bold: true}),
+			runetui.Text("Async Example", runetui.TextProps{Bold: true, Color: theme.Primary}),
 			runetui.Text(""),
 			content,
 			runetui.Text(""),
-			runetui.Text("Press q to quit", runetui.TextProps{Italic: true}),
+			runetui.Text("Press q to quit", runetui.TextProps{Italic: true, Color: theme.TextMuted}),
 		)
 	}
 
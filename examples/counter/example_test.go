@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,20 +19,23 @@ func TestCounterExample_RendersInitialState(t *testing.T) {
 	runetui.AssertContainsText(t, output, "Counter")
 }
 
+// countLine returns the bordered line that shows count.
+func countLine(count int) string {
+	return fmt.Sprintf("│%-24s│", fmt.Sprintf("Count: %d", count))
+}
+
 func TestCounterExample_IncrementOnKeyUp(t *testing.T) {
 	count := 0
 	rootFunc, updateFunc := createCounterApp(&count)
 
 	// Initial render
-	output1 := rtest.RenderToString(rootFunc, 40, 10)
-	runetui.AssertContainsText(t, output1, "0")
+	rtest.AssertLine(t, rtest.RenderToLines(rootFunc, 40, 10), 2, countLine(0))
 
 	// Simulate pressing 'k' (up)
 	updateFunc(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
 
 	// Render after increment
-	output2 := rtest.RenderToString(rootFunc, 40, 10)
-	runetui.AssertContainsText(t, output2, "1")
+	rtest.AssertLine(t, rtest.RenderToLines(rootFunc, 40, 10), 2, countLine(1))
 }
 
 func TestCounterExample_DecrementOnKeyDown(t *testing.T) {
@@ -39,15 +43,13 @@ func TestCounterExample_DecrementOnKeyDown(t *testing.T) {
 	rootFunc, updateFunc := createCounterApp(&count)
 
 	// Initial render
-	output1 := rtest.RenderToString(rootFunc, 40, 10)
-	runetui.AssertContainsText(t, output1, "5")
+	rtest.AssertLine(t, rtest.RenderToLines(rootFunc, 40, 10), 2, countLine(5))
 
 	// Simulate pressing 'j' (down)
 	updateFunc(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
 
 	// Render after decrement
-	output2 := rtest.RenderToString(rootFunc, 40, 10)
-	runetui.AssertContainsText(t, output2, "4")
+	rtest.AssertLine(t, rtest.RenderToLines(rootFunc, 40, 10), 2, countLine(4))
 }
 
 func TestCounterExample_Snapshot(t *testing.T) {
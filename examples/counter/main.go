@@ -1,6 +1,8 @@
 // Counter example demonstrates state management with RuneTUI.
 // This example shows how to use WithUpdate to handle keyboard input
 // and update application state following the Elm Architecture pattern.
+// Colors come from the app's theme, so switching to runetui.LightTheme()
+// restyles the whole app.
 package main
 
 import (
@@ -15,7 +17,7 @@ func main() {
 	count := 0
 	rootFunc, updateFunc := createCounterApp(&count)
 
-	app := runetui.New(rootFunc, runetui.WithUpdate(updateFunc))
+	app := runetui.New(rootFunc, runetui.WithUpdate(updateFunc), runetui.WithTheme(runetui.DarkTheme()))
 	if err := app.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -26,18 +28,21 @@ func main() {
 // The count pointer allows the state to be modified externally (useful for testing).
 func createCounterApp(count *int) (runetui.ComponentFunc, runetui.UpdateFunc) {
 	rootFunc := func() runetui.Component {
+		theme := runetui.CurrentTheme()
+		hint := runetui.TextProps{Italic: true, Color: theme.TextMuted}
 		return runetui.Box(
 			runetui.BoxProps{
-				Direction: runetui.Column,
-				Border:    runetui.BorderSingle,
-				Padding:   runetui.SpacingAll(1),
+				Direction:   runetui.Column,
+				Border:      runetui.BorderSingle,
+				BorderColor: theme.Primary,
+				Padding:     runetui.SpacingAll(1),
 			},
-			runetui.Text("Counter", runetui.TextProps{Bold: true}),
-			runetui.Text(fmt.Sprintf("Count: %d", *count)),
+			runetui.Text("Counter", runetui.TextProps{Bold: true, Color: theme.Primary}),
+			runetui.Text(fmt.Sprintf("Count: %d", *count), runetui.TextProps{Color: theme.Text}),
 			runetui.Text(""),
-			runetui.Text("Press k/↑ to increment", runetui.TextProps{Italic: true}),
-			runetui.Text("Press j/↓ to decrement", runetui.TextProps{Italic: true}),
-			runetui.Text("Press q to quit", runetui.TextProps{Italic: true}),
+			runetui.Text("Press k/↑ to increment", hint),
+			runetui.Text("Press j/↓ to decrement", hint),
+			runetui.Text("Press q to quit", hint),
 		)
 	}
 
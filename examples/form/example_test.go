@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -32,32 +33,53 @@ func TestFormExample_ShowsFieldValues(t *testing.T) {
 	runetui.AssertContainsText(t, output, "john@example.com")
 }
 
+// fieldLine returns the bordered line that shows a form field.
+func fieldLine(field string) string {
+	return fmt.Sprintf("│%-43s│", field)
+}
+
 func TestFormExample_NavigateFields(t *testing.T) {
 	state := &formState{focused: 0}
 	rootFunc, updateFunc := createFormApp(state)
 
 	// Initial: first field focused
-	output1 := rtest.RenderToString(rootFunc, 50, 15)
-	runetui.AssertContainsText(t, output1, "> Name")
+	lines := rtest.RenderToLines(rootFunc, 50, 15)
+	rtest.AssertLine(t, lines, 3, fieldLine("> Name: (empty)"))
+	rtest.AssertLine(t, lines, 4, fieldLine("  Email: (empty)"))
 
 	// Press tab to move to next field
 	updateFunc(tea.KeyMsg{Type: tea.KeyTab})
 
-	output2 := rtest.RenderToString(rootFunc, 50, 15)
-	runetui.AssertContainsText(t, output2, "> Email")
+	lines = rtest.RenderToLines(rootFunc, 50, 15)
+	rtest.AssertLine(t, lines, 3, fieldLine("  Name: (empty)"))
+	rtest.AssertLine(t, lines, 4, fieldLine("> Email: (empty)"))
 }
 
 func TestFormExample_TypeInField(t *testing.T) {
 	state := &formState{focused: 0}
 	rootFunc, updateFunc := createFormApp(state)
+	app := rtest.NewTestApp(rootFunc, runetui.WithUpdate(updateFunc))
 
-	// Type some characters
-	for _, r := range "Alice" {
-		updateFunc(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
-	}
+	app.Type("Alice")
 
-	output := rtest.RenderToString(rootFunc, 50, 15)
-	runetui.AssertContainsText(t, output, "Alice")
+	runetui.AssertContainsText(t, app.View(), "Alice")
+}
+
+func TestFormExample_TypeIntoSecondField(t *testing.T) {
+	state := &formState{focused: 0}
+	rootFunc, updateFunc := createFormApp(state)
+	app := rtest.NewTestApp(rootFunc, runetui.WithUpdate(updateFunc))
+
+	app.Type("Bob")
+	updateFunc(tea.KeyMsg{Type: tea.KeyTab})
+	app.Type("bob@example.com")
+
+	if state.name != "Bob" || state.email != "bob@example.com" {
+		t.Errorf("expected name and email to be typed, got %q and %q", state.name, state.email)
+	}
+	view := app.View()
+	runetui.AssertContainsText(t, view, "Bob")
+	runetui.AssertContainsText(t, view, "bob@example.com")
 }
 
 func TestFormExample_Snapshot(t *testing.T) {
@@ -1,6 +1,7 @@
 // Form example demonstrates structured state management with RuneTUI.
 // This example shows how to handle multiple input fields with navigation
-// following the Elm Architecture pattern.
+// following the Elm Architecture pattern. The focused field is highlighted
+// with the theme's primary color.
 package main
 
 import (
@@ -24,7 +25,7 @@ func main() {
 	state := &formState{}
 	rootFunc, updateFunc := createFormApp(state)
 
-	app := runetui.New(rootFunc, runetui.WithUpdate(updateFunc))
+	app := runetui.New(rootFunc, runetui.WithUpdate(updateFunc), runetui.WithTheme(runetui.DarkTheme()))
 	if err := app.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -33,19 +34,21 @@ func main() {
 // createFormApp creates a form application with state management.
 func createFormApp(state *formState) (runetui.ComponentFunc, runetui.UpdateFunc) {
 	rootFunc := func() runetui.Component {
+		theme := runetui.CurrentTheme()
 		return runetui.Box(
 			runetui.BoxProps{
-				Direction: runetui.Column,
-				Border:    runetui.BorderSingle,
-				Padding:   runetui.SpacingAll(1),
+				Direction:   runetui.Column,
+				Border:      runetui.BorderSingle,
+				BorderColor: theme.Primary,
+				Padding:     runetui.SpacingAll(1),
 			},
-			runetui.Text("Form Example", runetui.TextProps{Bold: true}),
+			runetui.Text("Form Example", runetui.TextProps{Bold: true, Color: theme.Primary}),
 			runetui.Text(""),
 			renderField("Name", state.name, state.focused == 0),
 			renderField("Email", state.email, state.focused == 1),
 			runetui.Text(""),
 			runetui.Text("Tab: next field | Enter: submit | q: quit",
-				runetui.TextProps{Italic: true}),
+				runetui.TextProps{Italic: true, Color: theme.TextMuted}),
 		)
 	}
 
@@ -88,13 +91,16 @@ func createFormApp(state *formState) (runetui.ComponentFunc, runetui.UpdateFunc)
 }
 
 func renderField(label, value string, focused bool) runetui.Component {
+	theme := runetui.CurrentTheme()
 	prefix := "  "
+	color := theme.Text
 	if focused {
 		prefix = "> "
+		color = theme.Primary
 	}
 	display := value
 	if display == "" {
 		display = "(empty)"
 	}
-	return runetui.Text(fmt.Sprintf("%s%s: %s", prefix, label, display))
+	return runetui.Text(fmt.Sprintf("%s%s: %s", prefix, label, display), runetui.TextProps{Color: color})
 }
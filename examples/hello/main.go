@@ -9,13 +9,12 @@ import (
 func main() {
 	app := runetui.New(func() runetui.Component {
 		return runetui.Box(
-			runetui.BoxProps{
-				Direction: runetui.Column,
-				Padding:   runetui.SpacingAll(2),
-				Border:    runetui.BorderSingle,
-			},
-			runetui.Text("Hello, RuneTUI!", runetui.TextProps{Bold: true}),
-			runetui.Text("Press Ctrl+C to quit"),
+			runetui.BoxProps{Border: runetui.BorderSingle},
+			runetui.PaddedBox(
+				runetui.SpacingAll(2),
+				runetui.Text("Hello, RuneTUI!", runetui.TextProps{Bold: true}),
+				runetui.Text("Press Ctrl+C to quit"),
+			),
 		)
 	})
 
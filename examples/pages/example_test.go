@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func TestPagesExample_StartsOnWelcomePage(t *testing.T) {
+	app := runetui.New(welcomePage)
+
+	output := rtest.RenderToString(app.RootFunc(), 40, 10)
+
+	runetui.AssertContainsText(t, output, "Welcome")
+}
+
+func TestPagesExample_EnterSwitchesToSummary(t *testing.T) {
+	app := runetui.New(welcomePage)
+
+	cmd := handleKey(app, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if cmd != nil {
+		t.Errorf("expected no command, got %v", cmd)
+	}
+	output := rtest.RenderToString(app.RootFunc(), 40, 10)
+	runetui.AssertContainsText(t, output, "Summary")
+}
+
+func TestPagesExample_QQuits(t *testing.T) {
+	app := runetui.New(welcomePage)
+
+	cmd := handleKey(app, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+	if cmd == nil {
+		t.Fatal("expected quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected tea.QuitMsg")
+	}
+}
+
+func TestPagesExample_Snapshot(t *testing.T) {
+	output := rtest.RenderToString(summaryPage, 40, 10)
+
+	rtest.AssertSnapshot(t, "summary_page", output)
+}
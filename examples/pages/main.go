@@ -0,0 +1,58 @@
+// Pages example demonstrates switching between views at runtime.
+// This example shows how to use App.SetRootFunc to replace the whole
+// component tree, moving from a welcome page to a summary page on Enter.
+package main
+
+import (
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+func main() {
+	var app *runetui.App
+	app = runetui.New(welcomePage, runetui.WithUpdate(func(msg tea.Msg) tea.Cmd {
+		return handleKey(app, msg)
+	}))
+	if err := app.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// handleKey advances to the next page on Enter and quits on q.
+func handleKey(app *runetui.App, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "enter":
+		app.SetRootFunc(summaryPage)
+	case "q":
+		return tea.Quit
+	}
+	return nil
+}
+
+// welcomePage is the first page shown when the app starts.
+func welcomePage() runetui.Component {
+	return page("Welcome", "Press Enter to continue")
+}
+
+// summaryPage is shown after the user presses Enter.
+func summaryPage() runetui.Component {
+	return page("Summary", "All done! Press q to quit")
+}
+
+func page(title, hint string) runetui.Component {
+	return runetui.Box(
+		runetui.BoxProps{
+			Direction: runetui.Column,
+			Border:    runetui.BorderRounded,
+			Padding:   runetui.SpacingAll(1),
+		},
+		runetui.Text(title, runetui.TextProps{Bold: true}),
+		runetui.Text(hint, runetui.TextProps{Italic: true}),
+	)
+}
@@ -153,3 +153,28 @@ func TestStreamingExample_QuitOnQ(t *testing.T) {
 		t.Error("expected quit command")
 	}
 }
+
+// TestStreamingExample_RestartOnR verifies that r clears the log zone and
+// starts a new stream.
+func TestStreamingExample_RestartOnR(t *testing.T) {
+	state := &streamState{
+		logs:   []string{"[12:00:00] Old entry"},
+		status: "Complete! Press q to quit",
+		ticks:  20,
+		static: runetui.NewStaticManager(),
+	}
+	state.static.AppendStatic("logs", state.logs)
+
+	_, updateFunc := createStreamingApp(state)
+	cmd := updateFunc(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+	if cmd == nil {
+		t.Error("expected a tick command to restart the stream")
+	}
+	if state.static.RenderStatic() != "" {
+		t.Errorf("expected the log zone to be cleared, got %q", state.static.RenderStatic())
+	}
+	if len(state.logs) != 1 || state.ticks != 1 {
+		t.Errorf("expected one fresh log entry, got %v (ticks %d)", state.logs, state.ticks)
+	}
+}
@@ -15,6 +15,8 @@
 //   - Content is re-rendered on every frame
 //   - Shows real-time updates
 //   - Perfect for: status bars, progress indicators, current state
+//
+// Colors are taken from the app's theme with runetui.CurrentTheme.
 package main
 
 import (
@@ -31,6 +33,9 @@ type streamState struct {
 	logs   []string
 	status string
 	ticks  int
+	// static is the app's static manager, used to clear the log zone on
+	// restart. It is nil in tests that render without an app.
+	static *runetui.StaticManager
 }
 
 // tickMsg is sent by the timer to trigger periodic log updates.
@@ -57,7 +62,9 @@ func main() {
 	app := runetui.New(rootFunc,
 		runetui.WithInit(initFunc),
 		runetui.WithUpdate(updateFunc),
+		runetui.WithTheme(runetui.DarkTheme()),
 	)
+	state.static = app.StaticManager()
 	if err := app.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -66,10 +73,11 @@ func main() {
 // createStreamingApp creates a streaming log application.
 func createStreamingApp(state *streamState) (runetui.ComponentFunc, runetui.UpdateFunc) {
 	rootFunc := func() runetui.Component {
+		theme := runetui.CurrentTheme()
 		logComponents := make([]runetui.Component, 0, len(state.logs))
 		for _, logLine := range state.logs {
 			logComponents = append(logComponents,
-				runetui.Text(logLine, runetui.TextProps{Color: "#888888"}),
+				runetui.Text(logLine, runetui.TextProps{Color: theme.TextMuted}),
 			)
 		}
 
@@ -77,10 +85,10 @@ func createStreamingApp(state *streamState) (runetui.ComponentFunc, runetui.Upda
 			runetui.Box(
 				runetui.BoxProps{
 					Padding:    runetui.SpacingAll(1),
-					Background: "#005577",
+					Background: theme.Primary,
 				},
 				runetui.Text("Streaming Logs Example", runetui.TextProps{
-					Color: "#FFFFFF",
+					Color: theme.OnPrimary,
 					Bold:  true,
 				}),
 			),
@@ -88,19 +96,19 @@ func createStreamingApp(state *streamState) (runetui.ComponentFunc, runetui.Upda
 				return logComponents
 			}),
 			runetui.Text("────────────────────────────────────────",
-				runetui.TextProps{Color: "#444444"}),
+				runetui.TextProps{Color: theme.Surface}),
 			runetui.Box(
 				runetui.BoxProps{
-					Background: "#004455",
+					Background: theme.Surface,
 					Padding:    runetui.SpacingAll(1),
 				},
 				runetui.Text(state.status, runetui.TextProps{
-					Color: "#FFFFFF",
+					Color: theme.Text,
 					Bold:  true,
 				}),
 			),
-			runetui.Text("Press SPACE to add entry | q to quit",
-				runetui.TextProps{Color: "#666666"}),
+			runetui.Text("Press SPACE to add entry | r to restart | q to quit",
+				runetui.TextProps{Color: theme.TextMuted}),
 		)
 	}
 
@@ -130,6 +138,17 @@ func createStreamingApp(state *streamState) (runetui.ComponentFunc, runetui.Upda
 				state.logs = append(state.logs,
 					fmt.Sprintf("[%s] Manual entry added", timestamp))
 				state.status = fmt.Sprintf("Running... (%d entries)", len(state.logs))
+			case "r":
+				if state.static != nil {
+					state.static.Reset("logs")
+				}
+				timestamp := time.Now().Format("15:04:05")
+				state.logs = []string{fmt.Sprintf("[%s] Stream restarted", timestamp)}
+				state.ticks = 1
+				state.status = "Restarting..."
+				return tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+					return tickMsg(t)
+				})
 			}
 		}
 		return nil
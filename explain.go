@@ -0,0 +1,58 @@
+package runetui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Explain runs a full layout pass for root and returns a human-readable report
+// with one line per node, indented by depth, for example:
+//
+//	[sidebar] available=80x24 measured=34x9 margin=+0x0 padding=+2x2 border=+2x2 final=40x9
+//
+// available is the space the node was offered and measured is the size its
+// Measure returned. Box nodes also report the space their margin, padding and
+// border add, which measured already includes. final is the laid-out size,
+// which differs from measured when the node grows, stretches or fills.
+func (e *LayoutEngine) Explain(root Component) string {
+	tree := e.CalculateLayout(root)
+	var sb strings.Builder
+	explainNode(&sb, tree, 0)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// explainNode writes the report line for tree and its descendants.
+func explainNode(sb *strings.Builder, tree *LayoutTree, depth int) {
+	fmt.Fprintf(sb, "%s[%s] available=%dx%d measured=%dx%d ", strings.Repeat("  ", depth), componentLabel(tree.Component),
+		tree.available.Width, tree.available.Height, tree.measured.Width, tree.measured.Height)
+
+	if b, ok := tree.Component.(*box); ok {
+		borderWidth, borderHeight := borderSize(b.props)
+		fmt.Fprintf(sb, "margin=+%dx%d padding=+%dx%d border=+%dx%d ",
+			spacingWidth(b.props.Margin), spacingHeight(b.props.Margin),
+			spacingWidth(b.props.Padding), spacingHeight(b.props.Padding),
+			borderWidth, borderHeight)
+	}
+	fmt.Fprintf(sb, "final=%dx%d\n", tree.Layout.Width, tree.Layout.Height)
+
+	for _, child := range tree.Children {
+		explainNode(sb, child, depth+1)
+	}
+}
+
+// labeler is implemented by components that name their kind in Explain output.
+type labeler interface {
+	label() string
+}
+
+// componentLabel returns the component's key, or its kind when the key is
+// empty. Components without a label are named by their Go type.
+func componentLabel(c Component) string {
+	if key := c.Key(); key != "" {
+		return key
+	}
+	if l, ok := c.(labeler); ok {
+		return l.label()
+	}
+	return fmt.Sprintf("%T", c)
+}
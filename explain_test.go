@@ -0,0 +1,94 @@
+package runetui
+
+import "testing"
+
+func TestLayoutEngine_Explain_SingleText_ReportsOneLine(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+
+	got := engine.Explain(Text("Hello", TextProps{Key: "greeting"}))
+
+	expected := "[greeting] available=80x24 measured=5x1 final=5x1"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestLayoutEngine_Explain_BoxWithPaddingAndBorder_ReportsBreakdown(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	root := Box(BoxProps{Key: "panel", Padding: SpacingAll(1), Border: BorderSingle},
+		Text("Hello"),
+		Text("World!"),
+	)
+
+	got := engine.Explain(root)
+
+	expected := "[panel] available=80x24 measured=10x6 margin=+0x0 padding=+2x2 border=+2x2 final=10x6\n" +
+		"  [text] available=80x24 measured=5x1 final=5x1\n" +
+		"  [text] available=80x24 measured=6x1 final=6x1"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestLayoutEngine_Explain_NestedBoxes_IndentsByDepth(t *testing.T) {
+	engine := NewLayoutEngine(40, 10)
+
+	got := engine.Explain(VStack(HStack(Text("a"))))
+
+	expected := "[box] available=40x10 measured=1x1 margin=+0x0 padding=+0x0 border=+0x0 final=1x1\n" +
+		"  [box] available=40x10 measured=1x1 margin=+0x0 padding=+0x0 border=+0x0 final=1x1\n" +
+		"    [text] available=40x10 measured=1x1 final=1x1"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestLayoutEngine_Explain_GrowingChild_ReportsMeasureBeforeGrowth(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	root := Box(BoxProps{Key: "row", Direction: Row, Width: DimensionFixed(20)},
+		Box(BoxProps{Key: "grow", FlexGrow: 1}, Text("abc")),
+	)
+
+	got := engine.Explain(root)
+
+	expected := "[row] available=80x24 measured=20x1 margin=+0x0 padding=+0x0 border=+0x0 final=20x1\n" +
+		"  [grow] available=80x24 measured=3x1 margin=+0x0 padding=+0x0 border=+0x0 final=20x1\n" +
+		"    [text] available=80x24 measured=3x1 final=3x1"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestComponentLabel_UnknownComponentWithoutKey_UsesTypeName(t *testing.T) {
+	got := componentLabel(&mockComponent{})
+
+	if got != "*runetui.mockComponent" {
+		t.Errorf("expected type name, got %q", got)
+	}
+}
+
+func TestComponentLabel_Static_UsesKey(t *testing.T) {
+	got := componentLabel(Static(StaticProps{Key: "logs"}, func() []Component { return nil }))
+
+	if got != "logs" {
+		t.Errorf("expected 'logs', got %q", got)
+	}
+}
+
+func TestComponentLabel_WithoutKey_UsesComponentLabel(t *testing.T) {
+	tests := []struct {
+		component Component
+		expected  string
+	}{
+		{Box(BoxProps{}), "box"},
+		{CollapsibleSection(CollapsibleSectionProps{}, Text("body")), "collapsible"},
+		{ProgressBar(ProgressBarProps{}), "progress"},
+		{DirectionalSpacer(1), "spacer"},
+	}
+
+	for _, tt := range tests {
+		if got := componentLabel(tt.component); got != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Package export renders RuneTUI component trees to formats other than the
+// terminal, such as HTML for web dashboards or plain text for CI logs.
+//
+// Example usage:
+//
+//	root := runetui.Box(runetui.BoxProps{}, runetui.Text("Hello"))
+//	html := export.ToHTML(root, 80, 24)
+package export
+
+import (
+	"github.com/runetui/runetui"
+)
+
+// render lays out root within width x height and renders it to a string.
+// Containers render their own children, so only the root is rendered.
+func render(root runetui.Component, width, height int) string {
+	tree := runetui.NewLayoutEngine(width, height).CalculateLayout(root)
+	return tree.Component.Render(tree.Layout)
+}
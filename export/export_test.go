@@ -0,0 +1,28 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/runetui/runetui"
+)
+
+func TestRender_BoxWithChildren_RendersEachChildOnce(t *testing.T) {
+	root := runetui.Box(runetui.BoxProps{}, runetui.Text("One"), runetui.Text("Two"))
+
+	output := runetui.StripANSI(render(root, 80, 24))
+
+	if output != "One\nTwo" {
+		t.Errorf("expected %q, got %q", "One\nTwo", output)
+	}
+}
+
+func TestRender_Row_JoinsChildrenHorizontally(t *testing.T) {
+	root := runetui.HStack(runetui.Text("A"), runetui.Text("B"))
+
+	output := runetui.StripANSI(render(root, 80, 24))
+
+	if strings.Contains(output, "\n") || !strings.HasPrefix(output, "A") || !strings.Contains(output, "B") {
+		t.Errorf("expected A and B on one line, got %q", output)
+	}
+}
@@ -0,0 +1,202 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/runetui/runetui"
+)
+
+// csiPattern matches CSI escape sequences; only SGR sequences (m terminator)
+// affect the HTML output, the rest are dropped.
+var csiPattern = regexp.MustCompile(`\x1b\[([0-9;]*)([a-zA-Z])`)
+
+// basicColors are the 16 standard terminal colors, normal then bright.
+var basicColors = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// ToHTML renders root within width x height and returns it as an HTML <pre>
+// block. ANSI colors, bold, italic and underline are converted to inline CSS
+// on <span> elements, and the text is HTML-escaped.
+//
+// Example:
+//
+//	fmt.Fprint(w, export.ToHTML(dashboard(), 120, 40))
+func ToHTML(root runetui.Component, width, height int) string {
+	return ansiToHTML(render(root, width, height))
+}
+
+// sgrState is the text style accumulated from SGR codes.
+type sgrState struct {
+	foreground string
+	background string
+	bold       bool
+	italic     bool
+	underline  bool
+}
+
+// css returns the inline style for the state, or "" for unstyled text.
+func (s sgrState) css() string {
+	var rules []string
+	if s.foreground != "" {
+		rules = append(rules, "color:"+s.foreground)
+	}
+	if s.background != "" {
+		rules = append(rules, "background-color:"+s.background)
+	}
+	if s.bold {
+		rules = append(rules, "font-weight:bold")
+	}
+	if s.italic {
+		rules = append(rules, "font-style:italic")
+	}
+	if s.underline {
+		rules = append(rules, "text-decoration:underline")
+	}
+	return strings.Join(rules, ";")
+}
+
+// ansiToHTML converts ANSI-styled text to an HTML <pre> block.
+func ansiToHTML(s string) string {
+	var b strings.Builder
+	var state sgrState
+
+	b.WriteString("<pre>")
+	last := 0
+	for _, match := range csiPattern.FindAllStringSubmatchIndex(s, -1) {
+		writeStyled(&b, s[last:match[0]], state.css())
+		last = match[1]
+		if s[match[4]:match[5]] == "m" {
+			state = state.apply(s[match[2]:match[3]])
+		}
+	}
+	writeStyled(&b, s[last:], state.css())
+	b.WriteString("</pre>")
+
+	return b.String()
+}
+
+// writeStyled writes escaped text, wrapped in a span when style is set.
+func writeStyled(b *strings.Builder, text, style string) {
+	if text == "" {
+		return
+	}
+	if style == "" {
+		b.WriteString(html.EscapeString(text))
+		return
+	}
+	fmt.Fprintf(b, `<span style="%s">%s</span>`, style, html.EscapeString(text))
+}
+
+// apply returns the state after applying the semicolon-separated SGR params.
+// An empty parameter list is a reset, as in ESC[m.
+func (s sgrState) apply(params string) sgrState {
+	codes := parseParams(params)
+	if len(codes) == 0 {
+		return sgrState{}
+	}
+
+	for i := 0; i < len(codes); i++ {
+		code := codes[i]
+		switch {
+		case code == 0:
+			s = sgrState{}
+		case code == 1:
+			s.bold = true
+		case code == 3:
+			s.italic = true
+		case code == 4:
+			s.underline = true
+		case code == 22:
+			s.bold = false
+		case code == 23:
+			s.italic = false
+		case code == 24:
+			s.underline = false
+		case code >= 30 && code <= 37:
+			s.foreground = basicColors[code-30]
+		case code >= 90 && code <= 97:
+			s.foreground = basicColors[code-90+8]
+		case code == 39:
+			s.foreground = ""
+		case code >= 40 && code <= 47:
+			s.background = basicColors[code-40]
+		case code >= 100 && code <= 107:
+			s.background = basicColors[code-100+8]
+		case code == 49:
+			s.background = ""
+		case code == 38 || code == 48:
+			color, consumed := extendedColor(codes[i+1:])
+			i += consumed
+			if color == "" {
+				continue
+			}
+			if code == 38 {
+				s.foreground = color
+			} else {
+				s.background = color
+			}
+		}
+	}
+	return s
+}
+
+// extendedColor decodes the parameters following 38 or 48: either 5;n for the
+// 256-color palette or 2;r;g;b for true color. It returns the CSS color and the
+// number of parameters consumed; the color is "" when the parameters are invalid.
+func extendedColor(params []int) (string, int) {
+	if len(params) >= 2 && params[0] == 5 {
+		return paletteColor(params[1]), 2
+	}
+	if len(params) >= 4 && params[0] == 2 {
+		return fmt.Sprintf("#%02x%02x%02x", clampByte(params[1]), clampByte(params[2]), clampByte(params[3])), 4
+	}
+	return "", len(params)
+}
+
+// paletteColor returns the CSS color of a 256-color palette index.
+func paletteColor(index int) string {
+	switch {
+	case index < 0 || index > 255:
+		return ""
+	case index < 16:
+		return basicColors[index]
+	case index < 232:
+		index -= 16
+		return fmt.Sprintf("#%02x%02x%02x", cubeLevel(index/36), cubeLevel(index/6%6), cubeLevel(index%6))
+	default:
+		gray := 8 + (index-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+}
+
+// cubeLevel converts a 6x6x6 color cube coordinate to a channel value.
+func cubeLevel(level int) int {
+	if level == 0 {
+		return 0
+	}
+	return 55 + level*40
+}
+
+// clampByte limits a channel value to 0-255.
+func clampByte(value int) int {
+	return max(0, min(255, value))
+}
+
+// parseParams splits SGR parameters, treating empty fields as 0.
+func parseParams(params string) []int {
+	if params == "" {
+		return nil
+	}
+	fields := strings.Split(params, ";")
+	codes := make([]int, len(fields))
+	for i, field := range fields {
+		codes[i], _ = strconv.Atoi(field)
+	}
+	return codes
+}
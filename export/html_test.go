@@ -0,0 +1,82 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/runetui/runetui"
+)
+
+func TestToHTML_StyledText_UsesInlineCSS(t *testing.T) {
+	root := runetui.Text("Hi", runetui.TextProps{Color: "#FF0000", Bold: true})
+
+	output := ToHTML(root, 80, 24)
+
+	expected := `<pre><span style="color:#ff0000;font-weight:bold">Hi</span></pre>`
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestToHTML_PlainText_IsEscaped(t *testing.T) {
+	output := ToHTML(runetui.Text("<a & b>"), 80, 24)
+
+	if output != "<pre>&lt;a &amp; b&gt;</pre>" {
+		t.Errorf("unexpected output %q", output)
+	}
+}
+
+func TestAnsiToHTML_SGRCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain", "text", "<pre>text</pre>"},
+		{"bold", "\x1b[1mb\x1b[0m", `<pre><span style="font-weight:bold">b</span></pre>`},
+		{"italic", "\x1b[3mi\x1b[23mn", `<pre><span style="font-style:italic">i</span>n</pre>`},
+		{"underline", "\x1b[4mu\x1b[m", `<pre><span style="text-decoration:underline">u</span></pre>`},
+		{"basic foreground", "\x1b[31mr", `<pre><span style="color:#cd0000">r</span></pre>`},
+		{"bright background", "\x1b[104mb", `<pre><span style="background-color:#5c5cff">b</span></pre>`},
+		{"256 color", "\x1b[38;5;196mr", `<pre><span style="color:#ff0000">r</span></pre>`},
+		{"256 gray", "\x1b[48;5;232mg", `<pre><span style="background-color:#080808">g</span></pre>`},
+		{"true color", "\x1b[38;2;1;2;3mt", `<pre><span style="color:#010203">t</span></pre>`},
+		{"combined", "\x1b[1;32mok\x1b[22mx", `<pre><span style="color:#00cd00;font-weight:bold">ok</span><span style="color:#00cd00">x</span></pre>`},
+		{"default colors", "\x1b[31;41ma\x1b[39;49mb", `<pre><span style="color:#cd0000;background-color:#cd0000">a</span>b</pre>`},
+		{"non-SGR dropped", "a\x1b[2Kb", "<pre>ab</pre>"},
+		{"invalid extended", "\x1b[38;9mx", "<pre>x</pre>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ansiToHTML(tt.input); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAnsiToHTML_MultiLine_PreservesNewlines(t *testing.T) {
+	output := ansiToHTML("a\nb")
+
+	if !strings.Contains(output, "a\nb") {
+		t.Errorf("expected newline preserved, got %q", output)
+	}
+}
+
+func TestPaletteColor_Ranges(t *testing.T) {
+	tests := map[int]string{-1: "", 0: "#000000", 15: "#ffffff", 16: "#000000", 231: "#ffffff", 255: "#eeeeee", 256: ""}
+	for index, expected := range tests {
+		if got := paletteColor(index); got != expected {
+			t.Errorf("paletteColor(%d): expected %q, got %q", index, expected, got)
+		}
+	}
+}
+
+func TestExtendedColor_ClampsTrueColor(t *testing.T) {
+	color, consumed := extendedColor([]int{2, 300, -5, 16})
+
+	if color != "#ff0010" || consumed != 4 {
+		t.Errorf("unexpected result %q, %d", color, consumed)
+	}
+}
@@ -0,0 +1,24 @@
+package export
+
+import (
+	"os"
+
+	"github.com/runetui/runetui"
+)
+
+// ToPlainText renders root within width x height with all ANSI escape codes
+// removed, for screen readers and CI logs. Unlike a screenshot of a running
+// App, it works on a component directly.
+//
+// Example:
+//
+//	t.Log(export.ToPlainText(root, 80, 24))
+func ToPlainText(root runetui.Component, width, height int) string {
+	return runetui.StripANSI(render(root, width, height))
+}
+
+// ToPlainTextFile writes the ToPlainText output of root to path, creating or
+// truncating the file.
+func ToPlainTextFile(root runetui.Component, width, height int, path string) error {
+	return os.WriteFile(path, []byte(ToPlainText(root, width, height)), 0644)
+}
@@ -0,0 +1,44 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/runetui/runetui"
+)
+
+func TestToPlainText_StyledText_StripsANSI(t *testing.T) {
+	root := runetui.Text("Hi", runetui.TextProps{Color: "#FF0000", Bold: true})
+
+	output := ToPlainText(root, 80, 24)
+
+	if output != "Hi" {
+		t.Errorf("expected %q, got %q", "Hi", output)
+	}
+}
+
+func TestToPlainTextFile_WritesOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.txt")
+	root := runetui.Box(runetui.BoxProps{}, runetui.Text("One", runetui.TextProps{Italic: true}), runetui.Text("Two"))
+
+	if err := ToPlainTextFile(root, 80, 24, path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "One\nTwo" {
+		t.Errorf("expected %q, got %q", "One\nTwo", content)
+	}
+}
+
+func TestToPlainTextFile_InvalidPath_ReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "out.txt")
+
+	if err := ToPlainTextFile(runetui.Text("x"), 80, 24, path); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
@@ -127,6 +127,14 @@ func justifyColumn(children []*LayoutTree, props BoxProps, mainSize int) {
 		for i, child := range children {
 			child.Layout.Y = halfSpace + i*(child.Layout.Height+space)
 		}
+	case JustifySpaceEvenly:
+		totalHeight := getTotalHeight(children)
+		space := (mainSize - totalHeight) / (len(children) + 1)
+		before := 0
+		for i, child := range children {
+			child.Layout.Y = (i+1)*space + before
+			before += child.Layout.Height
+		}
 	}
 }
 
@@ -161,23 +169,44 @@ func justifyRow(children []*LayoutTree, props BoxProps, mainSize int) {
 		for i, child := range children {
 			child.Layout.X = halfSpace + i*(child.Layout.Width+space)
 		}
+	case JustifySpaceEvenly:
+		totalWidth := getTotalWidth(children)
+		space := (mainSize - totalWidth) / (len(children) + 1)
+		before := 0
+		for i, child := range children {
+			child.Layout.X = (i+1)*space + before
+			before += child.Layout.Width
+		}
 	}
 }
 
+// getTotalHeight returns the vertical span covered by children, from the
+// topmost top edge to the bottommost bottom edge. Children pulled over their
+// siblings by negative margins are counted once.
 func getTotalHeight(children []*LayoutTree) int {
 	if len(children) == 0 {
 		return 0
 	}
-	first := children[0].Layout.Y
-	last := children[len(children)-1]
-	return last.Layout.Y + last.Layout.Height - first
+	top := children[0].Layout.Y
+	bottom := top + children[0].Layout.Height
+	for _, child := range children[1:] {
+		top = min(top, child.Layout.Y)
+		bottom = max(bottom, child.Layout.Y+child.Layout.Height)
+	}
+	return bottom - top
 }
 
+// getTotalWidth returns the horizontal span covered by children, from the
+// leftmost left edge to the rightmost right edge.
 func getTotalWidth(children []*LayoutTree) int {
 	if len(children) == 0 {
 		return 0
 	}
-	first := children[0].Layout.X
-	last := children[len(children)-1]
-	return last.Layout.X + last.Layout.Width - first
+	left := children[0].Layout.X
+	right := left + children[0].Layout.Width
+	for _, child := range children[1:] {
+		left = min(left, child.Layout.X)
+		right = max(right, child.Layout.X+child.Layout.Width)
+	}
+	return right - left
 }
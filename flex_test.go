@@ -610,3 +610,97 @@ func TestGetTotalWidth_WithMultipleChildren_ReturnsSpan(t *testing.T) {
 		t.Errorf("expected 40 (35 + 15 - 10), got %d", result)
 	}
 }
+
+func TestGetTotalHeight_WithOverlappingChild_ReturnsBoundingSpan(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 10, Height: 3}},
+		{Layout: Layout{X: 0, Y: 1, Width: 10, Height: 1}},
+	}
+
+	result := getTotalHeight(children)
+
+	if result != 3 {
+		t.Errorf("expected 3 (bottom of first child), got %d", result)
+	}
+}
+
+func TestGetTotalHeight_WithChildPulledAboveFirst_ReturnsBoundingSpan(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 5, Width: 10, Height: 2}},
+		{Layout: Layout{X: 0, Y: 3, Width: 10, Height: 1}},
+	}
+
+	result := getTotalHeight(children)
+
+	if result != 4 {
+		t.Errorf("expected 4 (7 - 3), got %d", result)
+	}
+}
+
+func TestGetTotalWidth_WithOverlappingChild_ReturnsBoundingSpan(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 6, Height: 1}},
+		{Layout: Layout{X: 2, Y: 0, Width: 1, Height: 1}},
+	}
+
+	result := getTotalWidth(children)
+
+	if result != 6 {
+		t.Errorf("expected 6 (right edge of first child), got %d", result)
+	}
+}
+
+func TestJustifyContent_SpaceEvenly_Column_DistributesEqualGaps(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 10, Height: 2}},
+		{Layout: Layout{X: 0, Y: 2, Width: 10, Height: 2}},
+	}
+	props := BoxProps{Direction: Column, JustifyContent: JustifySpaceEvenly}
+
+	justifyContent(children, props, 10)
+
+	if children[0].Layout.Y != 2 || children[1].Layout.Y != 6 {
+		t.Errorf("expected Y=2 and Y=6, got %d and %d", children[0].Layout.Y, children[1].Layout.Y)
+	}
+}
+
+func TestJustifyContent_SpaceEvenly_Row_DistributesEqualGaps(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 3, Height: 1}},
+		{Layout: Layout{X: 3, Y: 0, Width: 3, Height: 1}},
+		{Layout: Layout{X: 6, Y: 0, Width: 3, Height: 1}},
+	}
+	props := BoxProps{Direction: Row, JustifyContent: JustifySpaceEvenly}
+
+	justifyContent(children, props, 17)
+
+	if children[0].Layout.X != 2 || children[1].Layout.X != 7 || children[2].Layout.X != 12 {
+		t.Errorf("expected X=2,7,12, got %d,%d,%d", children[0].Layout.X, children[1].Layout.X, children[2].Layout.X)
+	}
+}
+
+func TestJustifyContent_SpaceEvenly_SingleChild_Column_Centers(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 10, Height: 4}},
+	}
+	props := BoxProps{Direction: Column, JustifyContent: JustifySpaceEvenly}
+
+	justifyContent(children, props, 10)
+
+	if children[0].Layout.Y != 3 {
+		t.Errorf("expected Y=3 (equal space before and after), got %d", children[0].Layout.Y)
+	}
+}
+
+func TestJustifyContent_SpaceEvenly_SingleChild_Row_Centers(t *testing.T) {
+	children := []*LayoutTree{
+		{Layout: Layout{X: 0, Y: 0, Width: 4, Height: 1}},
+	}
+	props := BoxProps{Direction: Row, JustifyContent: JustifySpaceEvenly}
+
+	justifyContent(children, props, 10)
+
+	if children[0].Layout.X != 3 {
+		t.Errorf("expected X=3 (equal space before and after), got %d", children[0].Layout.X)
+	}
+}
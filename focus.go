@@ -0,0 +1,78 @@
+package runetui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// FocusManager tracks which of a fixed set of components has keyboard focus
+// and moves focus with Tab and Shift+Tab. Components are identified by their
+// keys; pass fm.FocusedKey() == props.Key as the Focused prop of inputs such
+// as TextInput and SelectList.
+//
+// Example:
+//
+//	focus := runetui.NewFocusManager("name", "email", "role")
+//
+//	update := func(msg tea.Msg) tea.Cmd {
+//	    if key, ok := msg.(tea.KeyMsg); ok && focus.HandleKey(key) {
+//	        return nil
+//	    }
+//	    // route msg to the focused component...
+//	}
+type FocusManager struct {
+	keys    []string
+	focused int
+}
+
+// NewFocusManager creates a FocusManager over keys in Tab order. The first
+// key starts with focus.
+func NewFocusManager(keys ...string) *FocusManager {
+	return &FocusManager{keys: keys}
+}
+
+// FocusedKey returns the key of the focused component, or "" when the
+// manager has no keys.
+func (fm *FocusManager) FocusedKey() string {
+	if len(fm.keys) == 0 {
+		return ""
+	}
+	return fm.keys[fm.focused]
+}
+
+// Next moves focus to the following key, wrapping from the last to the first.
+func (fm *FocusManager) Next() {
+	if len(fm.keys) == 0 {
+		return
+	}
+	fm.focused = (fm.focused + 1) % len(fm.keys)
+}
+
+// Prev moves focus to the preceding key, wrapping from the first to the last.
+func (fm *FocusManager) Prev() {
+	if len(fm.keys) == 0 {
+		return
+	}
+	fm.focused = (fm.focused - 1 + len(fm.keys)) % len(fm.keys)
+}
+
+// SetFocus moves focus to key. Unknown keys leave the focus unchanged.
+func (fm *FocusManager) SetFocus(key string) {
+	for i, k := range fm.keys {
+		if k == key {
+			fm.focused = i
+			return
+		}
+	}
+}
+
+// HandleKey moves focus on tab and shift+tab and reports whether it consumed
+// msg. Other keys are left for the focused component.
+func (fm *FocusManager) HandleKey(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "tab":
+		fm.Next()
+		return true
+	case "shift+tab":
+		fm.Prev()
+		return true
+	}
+	return false
+}
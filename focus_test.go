@@ -0,0 +1,122 @@
+package runetui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNewFocusManager_FocusesFirstKey(t *testing.T) {
+	fm := NewFocusManager("name", "email", "role")
+
+	if got := fm.FocusedKey(); got != "name" {
+		t.Errorf("expected %q, got %q", "name", got)
+	}
+}
+
+func TestNewFocusManager_NoKeys_FocusesNothing(t *testing.T) {
+	fm := NewFocusManager()
+
+	fm.Next()
+	fm.Prev()
+	fm.SetFocus("name")
+
+	if got := fm.FocusedKey(); got != "" {
+		t.Errorf("expected empty key, got %q", got)
+	}
+}
+
+func TestFocusManager_Next_AdvancesAndWrapsToFirst(t *testing.T) {
+	fm := NewFocusManager("name", "email", "role")
+
+	var got []string
+	for i := 0; i < 4; i++ {
+		fm.Next()
+		got = append(got, fm.FocusedKey())
+	}
+
+	expected := []string{"email", "role", "name", "email"}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestFocusManager_Prev_WrapsToLast(t *testing.T) {
+	fm := NewFocusManager("name", "email", "role")
+
+	fm.Prev()
+
+	if got := fm.FocusedKey(); got != "role" {
+		t.Errorf("expected %q, got %q", "role", got)
+	}
+	fm.Prev()
+	if got := fm.FocusedKey(); got != "email" {
+		t.Errorf("expected %q, got %q", "email", got)
+	}
+}
+
+func TestFocusManager_SingleKey_StaysFocused(t *testing.T) {
+	fm := NewFocusManager("only")
+
+	fm.Next()
+	fm.Prev()
+
+	if got := fm.FocusedKey(); got != "only" {
+		t.Errorf("expected %q, got %q", "only", got)
+	}
+}
+
+func TestFocusManager_SetFocus_MovesToKey(t *testing.T) {
+	fm := NewFocusManager("name", "email", "role")
+
+	fm.SetFocus("role")
+	fm.Next()
+
+	if got := fm.FocusedKey(); got != "name" {
+		t.Errorf("expected Next after role to wrap to %q, got %q", "name", got)
+	}
+}
+
+func TestFocusManager_SetFocus_UnknownKey_KeepsFocus(t *testing.T) {
+	fm := NewFocusManager("name", "email")
+	fm.Next()
+
+	fm.SetFocus("missing")
+
+	if got := fm.FocusedKey(); got != "email" {
+		t.Errorf("expected %q, got %q", "email", got)
+	}
+}
+
+func TestFocusManager_HandleKey_TabAndShiftTab(t *testing.T) {
+	fm := NewFocusManager("name", "email", "role")
+
+	if !fm.HandleKey(tea.KeyMsg{Type: tea.KeyTab}) {
+		t.Error("expected tab to be consumed")
+	}
+	if got := fm.FocusedKey(); got != "email" {
+		t.Errorf("expected %q after tab, got %q", "email", got)
+	}
+	if !fm.HandleKey(tea.KeyMsg{Type: tea.KeyShiftTab}) {
+		t.Error("expected shift+tab to be consumed")
+	}
+	fm.HandleKey(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if got := fm.FocusedKey(); got != "role" {
+		t.Errorf("expected shift+tab to wrap to %q, got %q", "role", got)
+	}
+}
+
+func TestFocusManager_HandleKey_OtherKeys_NotConsumed(t *testing.T) {
+	fm := NewFocusManager("name", "email")
+
+	consumed := fm.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+
+	if consumed {
+		t.Error("expected rune key not to be consumed")
+	}
+	if got := fm.FocusedKey(); got != "name" {
+		t.Errorf("expected focus to stay on %q, got %q", "name", got)
+	}
+}
@@ -0,0 +1,166 @@
+// Command gen scaffolds new RuneTUI components.
+//
+// The component subcommand writes a source file with a props struct, a
+// private component type and stubbed Component methods, plus a test file
+// with placeholder tests. Existing files are never overwritten unless -force
+// is given.
+//
+// Example usage:
+//
+//	go run github.com/runetui/runetui/gen@latest component --name MyWidget --pkg myapp
+//
+// This creates my_widget.go and my_widget_test.go in the current directory.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// ErrInvalidName is returned for component names that are not exported Go
+// identifiers.
+var ErrInvalidName = errors.New("invalid component name")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "component" {
+		fmt.Fprintln(stderr, "usage: gen component --name Name --pkg package [--dir dir] [--force]")
+		return 2
+	}
+
+	flags := flag.NewFlagSet("component", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("name", "", "component name, such as MyWidget")
+	pkg := flags.String("pkg", "", "package name of the generated files")
+	dir := flags.String("dir", ".", "directory to write the files to")
+	force := flags.Bool("force", false, "overwrite existing files")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if *name == "" || *pkg == "" {
+		fmt.Fprintln(stderr, "gen: --name and --pkg are required")
+		return 2
+	}
+
+	files, err := generate(*name, *pkg)
+	if err != nil {
+		fmt.Fprintf(stderr, "gen: %v\n", err)
+		return 1
+	}
+	for _, file := range files {
+		path := filepath.Join(*dir, file.name)
+		if err := writeFile(path, file.content, *force); err != nil {
+			fmt.Fprintf(stderr, "gen: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "created %s\n", path)
+	}
+	return 0
+}
+
+// generatedFile is a file produced by generate.
+type generatedFile struct {
+	name    string
+	content []byte
+}
+
+// generate renders the component and test files for the component name in
+// package pkg.
+func generate(name, pkg string) ([]generatedFile, error) {
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return nil, fmt.Errorf("%w: %q must be an exported Go identifier", ErrInvalidName, name)
+	}
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("%w: package %q is not a Go identifier", ErrInvalidName, pkg)
+	}
+
+	data := templateData{
+		Name:    name,
+		Type:    lowerFirst(name),
+		Package: pkg,
+	}
+	base := snakeCase(name)
+
+	var files []generatedFile
+	for _, t := range []struct {
+		name string
+		tmpl *template.Template
+	}{
+		{base + ".go", componentTemplate},
+		{base + "_test.go", testTemplate},
+	} {
+		var buf bytes.Buffer
+		if err := t.tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		content, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, generatedFile{name: t.name, content: content})
+	}
+	return files, nil
+}
+
+// writeFile writes content to path, refusing to replace an existing file
+// unless force is set.
+func writeFile(path string, content []byte, force bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// snakeCase converts a Go identifier such as MyWidget or HTTPServer into a
+// file name base such as my_widget or http_server.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// lowerFirst returns name with its leading run of capitals lowered, such as
+// myWidget for MyWidget or httpServer for HTTPServer.
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
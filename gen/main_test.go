@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"MyWidget":   "my_widget",
+		"Widget":     "widget",
+		"HTTPServer": "http_server",
+		"ProgressV2": "progress_v2",
+		"TreeView3D": "tree_view3_d",
+	}
+	for name, expected := range tests {
+		if got := snakeCase(name); got != expected {
+			t.Errorf("snakeCase(%q): expected %q, got %q", name, expected, got)
+		}
+	}
+}
+
+func TestLowerFirst(t *testing.T) {
+	tests := map[string]string{
+		"MyWidget":   "myWidget",
+		"HTTPServer": "httpServer",
+		"UI":         "ui",
+	}
+	for name, expected := range tests {
+		if got := lowerFirst(name); got != expected {
+			t.Errorf("lowerFirst(%q): expected %q, got %q", name, expected, got)
+		}
+	}
+}
+
+func TestGenerate_ComponentFile_DeclaresPropsTypeAndMethods(t *testing.T) {
+	files, err := generate("MyWidget", "myapp")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if files[0].name != "my_widget.go" || files[1].name != "my_widget_test.go" {
+		t.Fatalf("unexpected file names %q, %q", files[0].name, files[1].name)
+	}
+
+	file := parse(t, files[0].content)
+	if file.Name.Name != "myapp" {
+		t.Errorf("expected package myapp, got %s", file.Name.Name)
+	}
+	expected := []string{
+		"(*myWidget).Children", "(*myWidget).Key", "(*myWidget).Measure", "(*myWidget).Render",
+		"MyWidget", "MyWidgetProps", "myWidget",
+	}
+	if got := declarations(file); strings.Join(got, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected declarations %v, got %v", expected, got)
+	}
+}
+
+func TestGenerate_TestFile_HasPlaceholderTests(t *testing.T) {
+	files, err := generate("MyWidget", "myapp")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	expected := []string{"TestMyWidget_Key", "TestMyWidget_Measure", "TestMyWidget_Render"}
+	if got := declarations(parse(t, files[1].content)); strings.Join(got, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected tests %v, got %v", expected, got)
+	}
+}
+
+func TestGenerate_InvalidNames(t *testing.T) {
+	for _, tt := range []struct{ name, pkg string }{
+		{"myWidget", "myapp"},
+		{"My-Widget", "myapp"},
+		{"MyWidget", "my-app"},
+	} {
+		if _, err := generate(tt.name, tt.pkg); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("generate(%q, %q): expected ErrInvalidName, got %v", tt.name, tt.pkg, err)
+		}
+	}
+}
+
+func TestRun_WritesFilesAndRefusesOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{"component", "--name", "StatusBar", "--pkg", "ui", "--dir", dir}
+	var stdout, stderr bytes.Buffer
+
+	if code := run(args, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	for _, name := range []string{"status_bar.go", "status_bar_test.go"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s to be created: %v", name, err)
+		}
+	}
+
+	stderr.Reset()
+	if code := run(args, &stdout, &stderr); code != 1 || !strings.Contains(stderr.String(), "exists") {
+		t.Errorf("expected overwrite to fail, got code %d: %s", code, stderr.String())
+	}
+	if code := run(append(args, "--force"), &stdout, &stderr); code != 0 {
+		t.Errorf("expected --force to overwrite, got code %d", code)
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"widget"},
+		{"component", "--name", "MyWidget"},
+	} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, &stdout, &stderr); code != 2 {
+			t.Errorf("%v: expected exit code 2, got %d", args, code)
+		}
+	}
+}
+
+func parse(t *testing.T, src []byte) *ast.File {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, src)
+	}
+	return file
+}
+
+// declarations returns the sorted top-level type and function names in file,
+// with methods written as (*recv).Name.
+func declarations(file *ast.File) []string {
+	var names []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil {
+				recv := decl.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+				name = "(*" + recv + ")." + name
+			}
+			names = append(names, name)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					names = append(names, ts.Name.Name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
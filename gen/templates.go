@@ -0,0 +1,87 @@
+package main
+
+import "text/template"
+
+// templateData is the input to the component and test templates.
+type templateData struct {
+	Name    string
+	Type    string
+	Package string
+}
+
+var componentTemplate = template.Must(template.New("component").Parse(`package {{.Package}}
+
+import "github.com/runetui/runetui"
+
+// {{.Name}}Props defines properties for the {{.Name}} component.
+type {{.Name}}Props struct {
+	Key string
+}
+
+// {{.Type}} is the private implementation of the {{.Name}} component.
+type {{.Type}} struct {
+	props {{.Name}}Props
+}
+
+// {{.Name}} creates a new {{.Name}} component.
+func {{.Name}}(props {{.Name}}Props) runetui.Component {
+	return &{{.Type}}{props: props}
+}
+
+// Render generates the component's output within layout.
+func (c *{{.Type}}) Render(layout runetui.Layout) string {
+	// TODO: render the component.
+	return ""
+}
+
+// Children returns an empty slice since {{.Name}} has no children.
+func (c *{{.Type}}) Children() []runetui.Component {
+	return []runetui.Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (c *{{.Type}}) Key() string {
+	return c.props.Key
+}
+
+// Measure returns the size the component needs within the available space.
+func (c *{{.Type}}) Measure(availableWidth, availableHeight int) runetui.Size {
+	// TODO: measure the component.
+	return runetui.Size{}
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(`package {{.Package}}
+
+import (
+	"testing"
+
+	"github.com/runetui/runetui"
+)
+
+func Test{{.Name}}_Key(t *testing.T) {
+	c := {{.Name}}({{.Name}}Props{Key: "{{.Type}}"})
+
+	if c.Key() != "{{.Type}}" {
+		t.Errorf("expected key %q, got %q", "{{.Type}}", c.Key())
+	}
+}
+
+func Test{{.Name}}_Render(t *testing.T) {
+	t.Skip("TODO: assert on {{.Name}} output")
+
+	output := {{.Name}}({{.Name}}Props{}).Render(runetui.Layout{Width: 80, Height: 24})
+
+	runetui.AssertNotEmpty(t, output)
+}
+
+func Test{{.Name}}_Measure(t *testing.T) {
+	t.Skip("TODO: assert on {{.Name}} size")
+
+	size := {{.Name}}({{.Name}}Props{}).Measure(80, 24)
+
+	if size.Width == 0 || size.Height == 0 {
+		t.Errorf("expected a non-empty size, got %+v", size)
+	}
+}
+`))
@@ -0,0 +1,42 @@
+package runetui
+
+import (
+	"fmt"
+	"math"
+)
+
+// Gradient returns the hex color at position t between the hex colors from
+// and to, where t is clamped to 0-1. Colors are "#RRGGBB" or the short
+// "#RGB" form; if either cannot be parsed, from is returned unchanged.
+//
+// Example:
+//
+//	Gradient("#000000", "#FFFFFF", 0.5) // "#808080"
+func Gradient(from, to string, t float64) string {
+	r1, g1, b1, ok1 := parseHexColor(from)
+	r2, g2, b2, ok2 := parseHexColor(to)
+	if !ok1 || !ok2 {
+		return from
+	}
+	if math.IsNaN(t) {
+		t = 0
+	}
+	t = math.Max(0, math.Min(t, 1))
+	mix := func(a, b int) int {
+		return int(math.Round(float64(a) + (float64(b)-float64(a))*t))
+	}
+	return fmt.Sprintf("#%02X%02X%02X", mix(r1, r2), mix(g1, g2), mix(b1, b2))
+}
+
+// parseHexColor splits a "#RRGGBB" or "#RGB" color into its channels.
+func parseHexColor(s string) (r, g, b int, ok bool) {
+	switch len(s) {
+	case 7:
+		_, err := fmt.Sscanf(s, "#%02x%02x%02x", &r, &g, &b)
+		return r, g, b, err == nil
+	case 4:
+		_, err := fmt.Sscanf(s, "#%1x%1x%1x", &r, &g, &b)
+		return r * 17, g * 17, b * 17, err == nil
+	}
+	return 0, 0, 0, false
+}
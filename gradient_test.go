@@ -0,0 +1,38 @@
+package runetui
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGradient_InterpolatesChannels(t *testing.T) {
+	tests := []struct {
+		from, to string
+		t        float64
+		expected string
+	}{
+		{"#000000", "#FFFFFF", 0, "#000000"},
+		{"#000000", "#FFFFFF", 1, "#FFFFFF"},
+		{"#000000", "#FFFFFF", 0.5, "#808080"},
+		{"#FF0000", "#0000FF", 0.25, "#BF0040"},
+		{"#000", "#FFF", 1, "#FFFFFF"},
+		{"#000000", "#FFFFFF", -1, "#000000"},
+		{"#000000", "#FFFFFF", 2, "#FFFFFF"},
+		{"#000000", "#FFFFFF", math.NaN(), "#000000"},
+	}
+
+	for _, tt := range tests {
+		if got := Gradient(tt.from, tt.to, tt.t); got != tt.expected {
+			t.Errorf("Gradient(%q, %q, %v): expected %q, got %q", tt.from, tt.to, tt.t, tt.expected, got)
+		}
+	}
+}
+
+func TestGradient_InvalidColor_ReturnsFrom(t *testing.T) {
+	if got := Gradient("red", "#FFFFFF", 0.5); got != "red" {
+		t.Errorf("expected from color, got %q", got)
+	}
+	if got := Gradient("#000000", "blue", 0.5); got != "#000000" {
+		t.Errorf("expected from color, got %q", got)
+	}
+}
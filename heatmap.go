@@ -0,0 +1,166 @@
+package runetui
+
+import (
+	"math"
+	"strings"
+)
+
+// HeatmapProps defines properties for the Heatmap component.
+// MinColor and MaxColor are hex colors for the lowest and highest values;
+// CellChar defaults to '█' and CellWidth to 2. RowLabels are drawn left of
+// each row and ColLabels above each column, clipped to CellWidth.
+type HeatmapProps struct {
+	MinColor  string
+	MaxColor  string
+	CellChar  rune
+	CellWidth int
+	RowLabels []string
+	ColLabels []string
+	Key       string
+}
+
+func (HeatmapProps) isProps() {}
+
+// heatmap is the private implementation of the Heatmap component.
+type heatmap struct {
+	data  [][]float64
+	props HeatmapProps
+}
+
+// Heatmap creates a grid of colored cells, one per value in data. Each value
+// is scaled between the smallest and largest values in data and colored with
+// Gradient from MinColor to MaxColor. NaN values are left blank.
+//
+// Example:
+//
+//	Heatmap([][]float64{{0, 1, 2}, {3, 4, 5}}, HeatmapProps{
+//	    MinColor:  "#0E4429",
+//	    MaxColor:  "#39D353",
+//	    RowLabels: []string{"Mon", "Tue"},
+//	})
+func Heatmap(data [][]float64, props HeatmapProps) Component {
+	if props.CellChar == 0 {
+		props.CellChar = '█'
+	}
+	if props.CellWidth <= 0 {
+		props.CellWidth = 2
+	}
+	if props.MinColor == "" {
+		props.MinColor = "#000000"
+	}
+	if props.MaxColor == "" {
+		props.MaxColor = "#FFFFFF"
+	}
+	return &heatmap{data: data, props: props}
+}
+
+// Render generates the optional column label row and one line per data row.
+func (h *heatmap) Render(layout Layout) string {
+	low, high := h.bounds()
+	labelWidth := h.labelWidth()
+	blank := strings.Repeat(" ", h.props.CellWidth)
+	cell := strings.Repeat(string(h.props.CellChar), h.props.CellWidth)
+
+	lines := make([]string, 0, len(h.data)+1)
+	if len(h.props.ColLabels) > 0 {
+		var b strings.Builder
+		b.WriteString(strings.Repeat(" ", labelWidth))
+		for col := 0; col < h.columns(); col++ {
+			label := ""
+			if col < len(h.props.ColLabels) {
+				label = h.props.ColLabels[col]
+			}
+			b.WriteString(Text(label, TextProps{Wrap: WrapNone}).Render(Layout{Width: h.props.CellWidth}))
+		}
+		lines = append(lines, b.String())
+	}
+
+	for row, values := range h.data {
+		var b strings.Builder
+		if labelWidth > 0 {
+			label := ""
+			if row < len(h.props.RowLabels) {
+				label = h.props.RowLabels[row]
+			}
+			b.WriteString(Text(label).Render(Layout{Width: labelWidth - 1}) + " ")
+		}
+		for col := 0; col < h.columns(); col++ {
+			if col >= len(values) || math.IsNaN(values[col]) {
+				b.WriteString(blank)
+				continue
+			}
+			t := 0.0
+			if high > low {
+				t = (values[col] - low) / (high - low)
+			}
+			color := Gradient(h.props.MinColor, h.props.MaxColor, t)
+			b.WriteString(Text(cell, TextProps{Color: color}).Render(Layout{Width: h.props.CellWidth}))
+		}
+		lines = append(lines, b.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// bounds returns the smallest and largest non-NaN values in the data.
+func (h *heatmap) bounds() (low, high float64) {
+	low, high = math.Inf(1), math.Inf(-1)
+	for _, values := range h.data {
+		for _, v := range values {
+			if math.IsNaN(v) {
+				continue
+			}
+			low, high = math.Min(low, v), math.Max(high, v)
+		}
+	}
+	return low, high
+}
+
+// columns returns the width of the widest data row, in cells.
+func (h *heatmap) columns() int {
+	columns := 0
+	for _, values := range h.data {
+		columns = max(columns, len(values))
+	}
+	return columns
+}
+
+// labelWidth returns the row label column width including its trailing
+// space, or 0 without row labels.
+func (h *heatmap) labelWidth() int {
+	width := 0
+	for _, label := range h.props.RowLabels {
+		width = max(width, len([]rune(label)))
+	}
+	if width == 0 {
+		return 0
+	}
+	return width + 1
+}
+
+// Children returns an empty slice since heatmaps have no children.
+func (h *heatmap) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (h *heatmap) Key() string {
+	return h.props.Key
+}
+
+func (h *heatmap) label() string {
+	return "heatmap"
+}
+
+// Measure returns CellWidth per column and one row per data row, plus room
+// for any row and column labels.
+func (h *heatmap) Measure(availableWidth, availableHeight int) Size {
+	height := len(h.data)
+	if len(h.props.ColLabels) > 0 {
+		height++
+	}
+	return Size{
+		Width:  h.labelWidth() + h.columns()*h.props.CellWidth,
+		Height: height,
+	}
+}
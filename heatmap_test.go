@@ -0,0 +1,79 @@
+package runetui
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestHeatmap_Measure_CellWidthPerColumn(t *testing.T) {
+	data := [][]float64{{1, 2, 3}, {4, 5, 6}}
+
+	size := Heatmap(data, HeatmapProps{CellWidth: 3}).Measure(80, 24)
+
+	if size.Width != 9 || size.Height != 2 {
+		t.Errorf("expected 9x2, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestHeatmap_Measure_IncludesLabels(t *testing.T) {
+	data := [][]float64{{1, 2}, {3, 4}}
+
+	size := Heatmap(data, HeatmapProps{
+		RowLabels: []string{"Mon", "Tuesday"},
+		ColLabels: []string{"a", "b"},
+	}).Measure(80, 24)
+
+	if size.Width != 8+2*2 || size.Height != 3 {
+		t.Errorf("expected 12x3, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestHeatmap_Render_ColorsByIntensity(t *testing.T) {
+	data := [][]float64{{0, 5, 10}}
+
+	output := Heatmap(data, HeatmapProps{MinColor: "#000000", MaxColor: "#FF0000", CellWidth: 1}).Render(Layout{})
+
+	for _, rgb := range []string{"38;2;0;0;0", "38;2;128;0;0", "38;2;255;0;0"} {
+		if !strings.Contains(output, rgb) {
+			t.Errorf("expected color %s in %q", rgb, output)
+		}
+	}
+	if StripANSI(output) != "███" {
+		t.Errorf("unexpected cells %q", StripANSI(output))
+	}
+}
+
+func TestHeatmap_Render_LabelsAndCustomChar(t *testing.T) {
+	data := [][]float64{{1, 2}, {3, math.NaN()}}
+
+	output := StripANSI(Heatmap(data, HeatmapProps{
+		CellChar:  '#',
+		RowLabels: []string{"Mon", "Tue"},
+		ColLabels: []string{"W1", "W2"},
+	}).Render(Layout{}))
+
+	expected := "    W1W2\nMon ####\nTue ##  "
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestHeatmap_Render_EqualValuesUseMinColor(t *testing.T) {
+	output := Heatmap([][]float64{{7, 7}}, HeatmapProps{MinColor: "#112233", MaxColor: "#FFFFFF"}).Render(Layout{})
+
+	if !strings.Contains(output, "38;2;17;34;51") || strings.Contains(output, "38;2;255;255;255") {
+		t.Errorf("expected only the min color, got %q", output)
+	}
+}
+
+func TestHeatmap_Key(t *testing.T) {
+	c := Heatmap(nil, HeatmapProps{Key: "activity"})
+
+	if c.Key() != "activity" || len(c.Children()) != 0 {
+		t.Errorf("expected key and no children, got %q / %d", c.Key(), len(c.Children()))
+	}
+	if size := c.Measure(80, 24); size != (Size{}) {
+		t.Errorf("expected empty size for no data, got %+v", size)
+	}
+}
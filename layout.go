@@ -1,9 +1,31 @@
 package runetui
 
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
 // LayoutEngine calculates positions for components based on terminal dimensions.
 type LayoutEngine struct {
 	terminalWidth  int
 	terminalHeight int
+	cache          map[cacheKey]cachedLayout
+	nextCache      map[cacheKey]cachedLayout
+}
+
+// cacheKey identifies a measurement by component pointer and available space.
+type cacheKey struct {
+	component       Component
+	direction       Direction
+	availableWidth  int
+	availableHeight int
+}
+
+// cachedLayout stores a measured size and the children it was measured with.
+type cachedLayout struct {
+	size     Size
+	children []Component
 }
 
 // NewLayoutEngine creates a new layout engine with the given terminal dimensions.
@@ -14,20 +36,340 @@ func NewLayoutEngine(width, height int) *LayoutEngine {
 	}
 }
 
+// TerminalWidth returns the terminal width the engine lays components out in.
+func (e *LayoutEngine) TerminalWidth() int {
+	return e.terminalWidth
+}
+
+// TerminalHeight returns the terminal height the engine lays components out in.
+func (e *LayoutEngine) TerminalHeight() int {
+	return e.terminalHeight
+}
+
+// SetDimensions changes the terminal size used by later CalculateLayout calls,
+// for example after a resize. Trees calculated before keep their old layout
+// and should be recalculated.
+func (e *LayoutEngine) SetDimensions(width, height int) {
+	e.terminalWidth = width
+	e.terminalHeight = height
+}
+
 // LayoutTree represents a component and its calculated layout along with its children.
+// Parent pointers are set by CalculateLayout; trees built by hand have none.
 type LayoutTree struct {
 	Component Component
 	Layout    Layout
 	Children  []*LayoutTree
+
+	parent *LayoutTree
+	// available is the space the component was offered and measured is the
+	// size its Measure returned, before growing or stretching. Explain
+	// reports both.
+	available Size
+	measured  Size
+}
+
+// Parent returns the node that contains this one, or nil for the root.
+func (t *LayoutTree) Parent() *LayoutTree {
+	return t.parent
+}
+
+// Root returns the topmost ancestor of this node, or the node itself.
+func (t *LayoutTree) Root() *LayoutTree {
+	root := t
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// Depth returns the number of ancestors of this node; the root has depth 0.
+func (t *LayoutTree) Depth() int {
+	depth := 0
+	for node := t.parent; node != nil; node = node.parent {
+		depth++
+	}
+	return depth
+}
+
+// Visit calls fn on this node and its descendants in depth-first pre-order,
+// parents before their children. Traversal stops as soon as fn returns false.
+func (t *LayoutTree) Visit(fn func(*LayoutTree) bool) {
+	t.visit(fn)
+}
+
+// visit is Visit, reporting whether traversal should continue.
+func (t *LayoutTree) visit(fn func(*LayoutTree) bool) bool {
+	if t == nil {
+		return true
+	}
+	if !fn(t) {
+		return false
+	}
+	for _, child := range t.Children {
+		if !child.visit(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the first node in depth-first pre-order whose component has
+// key, or nil if there is none.
+func (t *LayoutTree) Find(key string) *LayoutTree {
+	var found *LayoutTree
+	t.Visit(func(node *LayoutTree) bool {
+		if node.Component != nil && node.Component.Key() == key {
+			found = node
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// String returns an indented outline of the tree with one node per line,
+// showing each component's key (or kind), position and size.
+func (t *LayoutTree) String() string {
+	var sb strings.Builder
+	t.writeOutline(&sb, 0)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (t *LayoutTree) writeOutline(sb *strings.Builder, depth int) {
+	fmt.Fprintf(sb, "%s[%s] %d,%d %dx%d\n", strings.Repeat("  ", depth), componentLabel(t.Component),
+		t.Layout.X, t.Layout.Y, t.Layout.Width, t.Layout.Height)
+	for _, child := range t.Children {
+		child.writeOutline(sb, depth+1)
+	}
 }
 
 // CalculateLayout is the main entry point for layout calculation.
+// Measurements of clean pointer components are cached between calls, so a
+// tree that reuses the same unchanged instances is not re-measured. Entries
+// not used during a pass are dropped at the end of it.
 func (e *LayoutEngine) CalculateLayout(root Component) *LayoutTree {
-	return e.measureAndLayout(root, e.terminalWidth, e.terminalHeight, 0, 0)
+	e.nextCache = make(map[cacheKey]cachedLayout)
+	tree := e.measureAndLayout(root, Column, e.terminalWidth, e.terminalHeight, 0, 0)
+	e.cache, e.nextCache = e.nextCache, nil
+	return tree
+}
+
+// measure returns the component's size within a parent laid out in direction,
+// reusing a cached size when the same component instance is measured with the
+// same direction, available space and children. Only components whose
+// DirtyChecker reports them clean are cached, since others may have mutated
+// without changing their pointer.
+func (e *LayoutEngine) measure(component Component, direction Direction, availableWidth, availableHeight int) Size {
+	if e.nextCache == nil || reflect.ValueOf(component).Kind() != reflect.Pointer || isDirty(component) {
+		return measureChild(component, direction, availableWidth, availableHeight)
+	}
+
+	key := cacheKey{component: component, direction: direction, availableWidth: availableWidth, availableHeight: availableHeight}
+	children := component.Children()
+	if cached, ok := e.cache[key]; ok && sameChildren(cached.children, children) {
+		e.nextCache[key] = cached
+		return cached.size
+	}
+
+	size := measureChild(component, direction, availableWidth, availableHeight)
+	e.nextCache[key] = cachedLayout{size: size, children: children}
+	return size
+}
+
+// sameChildren reports whether two children slices share the same backing array and length.
+func sameChildren(a, b []Component) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return len(a) == 0 || &a[0] == &b[0]
+}
+
+// growChildren widens or heightens children with a FlexGrow factor or a
+// DimensionFill size by the free main-axis space of their box, and shifts the
+// children that follow. Fill children are also stretched on the cross axis.
+func growChildren(children []*LayoutTree, props BoxProps, layout Layout) {
+	components := make([]Component, len(children))
+	sizes := make([]Size, len(children))
+	for i, child := range children {
+		components[i] = child.Component
+		sizes[i] = Size{Width: child.Layout.Width, Height: child.Layout.Height}
+		if fillsCrossAxis(child.Component, props.Direction) {
+			stretchChild(child, props, layout)
+		}
+	}
+
+	offset := 0
+	for i, grow := range growAmounts(props, components, sizes, layout) {
+		if props.Direction == Row {
+			shiftTree(children[i], offset, 0)
+			children[i].Layout.Width += grow
+		} else {
+			shiftTree(children[i], 0, offset)
+			children[i].Layout.Height += grow
+		}
+		offset += grow
+	}
+}
+
+// growAmounts returns how much each child of a box laid out in layout grows
+// along the main axis, given the children's measured sizes. Children with a
+// FlexGrow factor share the free space in proportion; DimensionFill children
+// count as a factor of 1 and also receive the cells lost to rounding, so
+// together they use all of it. It returns nil when no child grows.
+func growAmounts(props BoxProps, children []Component, sizes []Size, layout Layout) []int {
+	flexChildren := make([]FlexChild, len(children))
+	fills := make([]bool, len(children))
+	growing := false
+	for i, child := range children {
+		b, ok := child.(*box)
+		if !ok {
+			continue
+		}
+		if fillsMainAxis(b, props.Direction) {
+			fills[i] = true
+			flexChildren[i].FlexGrow = 1
+			growing = true
+		}
+		if b.props.FlexGrow > 0 {
+			flexChildren[i].FlexGrow = b.props.FlexGrow
+			growing = true
+		}
+	}
+	if !growing {
+		return nil
+	}
+
+	borderWidth, borderHeight := borderSize(props)
+	var available int
+	if props.Direction == Row {
+		available = layout.Width - borderWidth - spacingWidth(props.Padding) - spacingWidth(props.Margin)
+	} else {
+		available = layout.Height - borderHeight - spacingHeight(props.Padding) - spacingHeight(props.Margin)
+	}
+	for i := 0; i < len(children)-1; i++ {
+		available -= childSpacing(props, children, i)
+	}
+	for _, size := range sizes {
+		if props.Direction == Row {
+			available -= size.Width
+		} else {
+			available -= size.Height
+		}
+	}
+
+	grows := calculateFlexGrow(flexChildren, available)
+	remainder := available
+	for _, grow := range grows {
+		remainder -= grow
+	}
+	for i := range grows {
+		if remainder <= 0 {
+			break
+		}
+		if fills[i] {
+			grows[i]++
+			remainder--
+		}
+	}
+	return grows
+}
+
+// hasGrowingChild reports whether any of children is a box with a FlexGrow
+// factor or a DimensionFill size along direction.
+func hasGrowingChild(children []Component, direction Direction) bool {
+	for _, child := range children {
+		if b, ok := child.(*box); ok && (b.props.FlexGrow > 0 || fillsMainAxis(b, direction)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fillsMainAxis reports whether b uses DimensionFill along direction.
+func fillsMainAxis(b *box, direction Direction) bool {
+	dim := b.props.Height
+	if direction == Row {
+		dim = b.props.Width
+	}
+	_, ok := dim.(dimensionFill)
+	return ok
+}
+
+// fillsCrossAxis reports whether component is a box that uses DimensionFill
+// across direction.
+func fillsCrossAxis(component Component, direction Direction) bool {
+	b, ok := component.(*box)
+	if !ok {
+		return false
+	}
+	dim := b.props.Width
+	if direction == Row {
+		dim = b.props.Height
+	}
+	_, ok = dim.(dimensionFill)
+	return ok
+}
+
+// stretchChild sets the cross-axis size of child to the inner size of its
+// parent box.
+func stretchChild(child *LayoutTree, props BoxProps, layout Layout) {
+	borderWidth, borderHeight := borderSize(props)
+	if props.Direction == Row {
+		child.Layout.Height = max(0, layout.Height-borderHeight-spacingHeight(props.Padding)-spacingHeight(props.Margin))
+	} else {
+		child.Layout.Width = max(0, layout.Width-borderWidth-spacingWidth(props.Padding)-spacingWidth(props.Margin))
+	}
+}
+
+// justifyChildren distributes children along the main axis of a box laid out
+// in layout according to its JustifyContent. justifyContent places children
+// relative to the box's content area, so they are moved into it and back, and
+// their descendants follow them.
+func justifyChildren(children []*LayoutTree, props BoxProps, layout Layout) {
+	if props.JustifyContent == JustifyStart || len(children) == 0 {
+		return
+	}
+
+	borderWidth, borderHeight := borderSize(props)
+	borderLeft, borderTop := borderOffset(props)
+	originX := layout.X + props.Padding.Left + borderLeft
+	originY := layout.Y + props.Padding.Top + borderTop
+	mainSize := layout.Height - borderHeight - spacingHeight(props.Padding) - spacingHeight(props.Margin)
+	if props.Direction == Row {
+		mainSize = layout.Width - borderWidth - spacingWidth(props.Padding) - spacingWidth(props.Margin)
+	}
+
+	placed := make([]Layout, len(children))
+	for i, child := range children {
+		placed[i] = child.Layout
+		child.Layout.X -= originX
+		child.Layout.Y -= originY
+	}
+	justifyContent(children, props, mainSize)
+	for i, child := range children {
+		dx := child.Layout.X + originX - placed[i].X
+		dy := child.Layout.Y + originY - placed[i].Y
+		child.Layout = placed[i]
+		shiftTree(child, dx, dy)
+	}
 }
 
-// measureAndLayout recursively measures and positions components.
-func (e *LayoutEngine) measureAndLayout(component Component, availableWidth, availableHeight, x, y int) *LayoutTree {
+// shiftTree moves a layout tree and all its descendants by dx, dy.
+func shiftTree(tree *LayoutTree, dx, dy int) {
+	if dx == 0 && dy == 0 {
+		return
+	}
+	tree.Layout.X += dx
+	tree.Layout.Y += dy
+	for _, child := range tree.Children {
+		shiftTree(child, dx, dy)
+	}
+}
+
+// measureAndLayout recursively measures and positions components. direction
+// is the layout direction of the parent; the root is treated as a column.
+func (e *LayoutEngine) measureAndLayout(component Component, direction Direction, availableWidth, availableHeight, x, y int) *LayoutTree {
 	marginLeft := 0
 	marginTop := 0
 
@@ -39,7 +381,7 @@ func (e *LayoutEngine) measureAndLayout(component Component, availableWidth, ava
 	adjustedX := x + marginLeft
 	adjustedY := y + marginTop
 
-	size := component.Measure(availableWidth, availableHeight)
+	size := e.measure(component, direction, availableWidth, availableHeight)
 
 	layout := Layout{
 		X:      adjustedX,
@@ -56,38 +398,64 @@ func (e *LayoutEngine) measureAndLayout(component Component, availableWidth, ava
 			paddingLeft := b.props.Padding.Left
 			paddingTop := b.props.Padding.Top
 
-			borderWidth, borderHeight := borderSize(b.props.Border)
-			borderLeft := borderWidth / 2
-			borderTop := borderHeight / 2
+			borderLeft, borderTop := borderOffset(b.props)
 
 			switch b.props.Direction {
 			case Column:
 				currentY := adjustedY + paddingTop + borderTop
 				for i, child := range children {
-					childTree := e.measureAndLayout(child, availableWidth, availableHeight, adjustedX+paddingLeft+borderLeft, currentY)
+					childTree := e.measureAndLayout(child, b.props.Direction, availableWidth, availableHeight, adjustedX+paddingLeft+borderLeft, currentY)
 					childTrees = append(childTrees, childTree)
 					currentY += childTree.Layout.Height
-					if i < len(children)-1 && b.props.Gap > 0 {
-						currentY += b.props.Gap
+					if i < len(children)-1 {
+						currentY += childSpacing(b.props, children, i)
 					}
 				}
 			case Row:
 				currentX := adjustedX + paddingLeft + borderLeft
 				for i, child := range children {
-					childTree := e.measureAndLayout(child, availableWidth, availableHeight, currentX, adjustedY+paddingTop+borderTop)
+					childTree := e.measureAndLayout(child, b.props.Direction, availableWidth, availableHeight, currentX, adjustedY+paddingTop+borderTop)
 					childTrees = append(childTrees, childTree)
 					currentX += childTree.Layout.Width
-					if i < len(children)-1 && b.props.Gap > 0 {
-						currentX += b.props.Gap
+					if i < len(children)-1 {
+						currentX += childSpacing(b.props, children, i)
 					}
 				}
 			}
+			growChildren(childTrees, b.props, layout)
+			justifyChildren(childTrees, b.props, layout)
 		}
 	}
 
-	return &LayoutTree{
+	tree := &LayoutTree{
 		Component: component,
 		Layout:    layout,
 		Children:  childTrees,
+		available: Size{Width: availableWidth, Height: availableHeight},
+		measured:  size,
+	}
+	for _, child := range childTrees {
+		child.parent = tree
 	}
+	return tree
+}
+
+// HitTest returns the deepest node of tree whose layout contains the cell at
+// x, y, or nil when the point lies outside the tree. Children are searched
+// last to first so that later siblings, which render on top, win.
+func (e *LayoutEngine) HitTest(tree *LayoutTree, x, y int) *LayoutTree {
+	if tree == nil || !layoutContains(tree.Layout, x, y) {
+		return nil
+	}
+	for i := len(tree.Children) - 1; i >= 0; i-- {
+		if hit := e.HitTest(tree.Children[i], x, y); hit != nil {
+			return hit
+		}
+	}
+	return tree
+}
+
+// layoutContains reports whether the cell at x, y lies inside l.
+func layoutContains(l Layout, x, y int) bool {
+	return x >= l.X && x < l.X+l.Width && y >= l.Y && y < l.Y+l.Height
 }
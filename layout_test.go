@@ -1,6 +1,9 @@
 package runetui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestLayoutEngine_SingleTextComponent_PositionedAtOrigin(t *testing.T) {
 	engine := NewLayoutEngine(80, 24)
@@ -204,3 +207,458 @@ func TestLayoutEngine_BoxWithGapRow_AddsSpaceBetweenChildren(t *testing.T) {
 		t.Errorf("second child X: expected %d (first width + gap), got %d", expectedSecondX, secondChild.Layout.X)
 	}
 }
+
+type countingComponent struct {
+	measures int
+	dirty    bool
+	children []Component
+}
+
+func (c *countingComponent) Dirty() bool { return c.dirty }
+
+func (c *countingComponent) Render(layout Layout) string { return "" }
+func (c *countingComponent) Children() []Component       { return c.children }
+func (c *countingComponent) Key() string                 { return "" }
+func (c *countingComponent) Measure(availableWidth, availableHeight int) Size {
+	c.measures++
+	return Size{Width: availableWidth / 2, Height: 1}
+}
+
+func TestLayoutEngine_SameComponentTwice_MeasuresOnce(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := &countingComponent{}
+
+	first := engine.CalculateLayout(component)
+	second := engine.CalculateLayout(component)
+
+	if component.measures != 1 {
+		t.Errorf("expected 1 measure call, got %d", component.measures)
+	}
+	if first.Layout != second.Layout {
+		t.Errorf("expected cached layout %+v, got %+v", first.Layout, second.Layout)
+	}
+}
+
+func TestLayoutEngine_DifferentAvailableSpace_Remeasures(t *testing.T) {
+	component := &countingComponent{}
+
+	NewLayoutEngine(80, 24).CalculateLayout(component)
+	tree := NewLayoutEngine(40, 24).CalculateLayout(component)
+
+	if component.measures != 2 {
+		t.Errorf("expected 2 measure calls, got %d", component.measures)
+	}
+	if tree.Layout.Width != 20 {
+		t.Errorf("expected width 20, got %d", tree.Layout.Width)
+	}
+}
+
+func TestLayoutEngine_ChildrenSliceChanged_Remeasures(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := &countingComponent{children: []Component{Text("a")}}
+
+	engine.CalculateLayout(component)
+	component.children = []Component{Text("a")}
+	engine.CalculateLayout(component)
+
+	if component.measures != 2 {
+		t.Errorf("expected 2 measure calls, got %d", component.measures)
+	}
+}
+
+func TestLayoutEngine_DirtyComponent_Remeasures(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := &countingComponent{dirty: true}
+
+	engine.CalculateLayout(component)
+	engine.CalculateLayout(component)
+
+	if component.measures != 2 {
+		t.Errorf("expected 2 measure calls, got %d", component.measures)
+	}
+}
+
+type mutableComponent struct {
+	width int
+}
+
+func (m *mutableComponent) Render(layout Layout) string { return "" }
+func (m *mutableComponent) Children() []Component       { return nil }
+func (m *mutableComponent) Key() string                 { return "" }
+func (m *mutableComponent) Measure(availableWidth, availableHeight int) Size {
+	return Size{Width: m.width, Height: 1}
+}
+
+func TestLayoutEngine_PointerComponentMutatedBetweenFrames_Remeasures(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := &mutableComponent{width: 5}
+
+	engine.CalculateLayout(component)
+	component.width = 12
+	tree := engine.CalculateLayout(component)
+
+	if tree.Layout.Width != 12 {
+		t.Errorf("expected width 12 after mutation, got %d", tree.Layout.Width)
+	}
+}
+
+func TestLayoutEngine_TextPropsMutatedBetweenFrames_Remeasures(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := Text("Hi", TextProps{Bold: true})
+	renderTree(engine.CalculateLayout(component))
+
+	component.(*text).props.Prefix = "> "
+	tree := engine.CalculateLayout(component)
+
+	if tree.Layout.Width != 4 {
+		t.Errorf("expected width 4 after props change, got %d", tree.Layout.Width)
+	}
+}
+
+func TestLayoutEngine_ComponentAbsentFromPass_IsEvicted(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	component := &countingComponent{}
+
+	engine.CalculateLayout(component)
+	engine.CalculateLayout(Text("other"))
+	engine.CalculateLayout(component)
+
+	if component.measures != 2 {
+		t.Errorf("expected 2 measure calls after eviction, got %d", component.measures)
+	}
+}
+
+func TestLayoutEngine_ComponentFunc_IsNotCached(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	calls := 0
+	root := ComponentFunc(func() Component {
+		calls++
+		return Text("Hello")
+	})
+
+	engine.CalculateLayout(root)
+	engine.CalculateLayout(root)
+
+	if calls == 0 {
+		t.Fatal("expected ComponentFunc to be evaluated")
+	}
+	if len(engine.cache) != 0 {
+		t.Errorf("expected no cache entries for ComponentFunc, got %d", len(engine.cache))
+	}
+}
+
+func buildBenchmarkTree() Component {
+	rows := make([]Component, 0, 50)
+	for i := 0; i < 50; i++ {
+		rows = append(rows, HStack(Text("label"), HorizontalSpacer(2), Text("a longer value for this row")))
+	}
+	return Box(BoxProps{Direction: Column, Border: BorderSingle}, rows...)
+}
+
+func BenchmarkLayoutEngine_CalculateLayout_CacheMiss(b *testing.B) {
+	engine := NewLayoutEngine(80, 24)
+	for i := 0; i < b.N; i++ {
+		engine.CalculateLayout(buildBenchmarkTree())
+	}
+}
+
+func BenchmarkLayoutEngine_CalculateLayout_CacheHit(b *testing.B) {
+	engine := NewLayoutEngine(80, 24)
+	root := buildBenchmarkTree()
+	renderTree(engine.CalculateLayout(root))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		engine.CalculateLayout(root)
+	}
+}
+
+func TestLayoutTree_String_OutlinesNodesByDepth(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	tree := engine.CalculateLayout(Box(BoxProps{Key: "root", Border: BorderSingle}, Text("Hi"), Text("There")))
+
+	got := tree.String()
+
+	expected := "[root] 0,0 7x4\n  [text] 1,1 2x1\n  [text] 1,2 5x1"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestGrowChildren_NoFlexChildren_LeavesLayoutUnchanged(t *testing.T) {
+	children := []*LayoutTree{
+		{Component: Text("a"), Layout: Layout{X: 0, Width: 1, Height: 1}},
+	}
+
+	growChildren(children, BoxProps{Direction: Row}, Layout{Width: 10, Height: 1})
+
+	if children[0].Layout.Width != 1 {
+		t.Errorf("expected width 1, got %d", children[0].Layout.Width)
+	}
+}
+
+func TestGrowChildren_AccountsForGapPaddingAndBorder(t *testing.T) {
+	children := []*LayoutTree{
+		{Component: FlexSpacer(), Layout: Layout{X: 2}},
+		{Component: Text("ab"), Layout: Layout{X: 3, Width: 2, Height: 1},
+			Children: []*LayoutTree{{Component: Text("ab"), Layout: Layout{X: 3, Width: 2, Height: 1}}}},
+	}
+	props := BoxProps{Direction: Row, Gap: 1, Padding: SpacingHorizontal(1), Border: BorderSingle}
+
+	growChildren(children, props, Layout{Width: 12, Height: 3})
+
+	if children[0].Layout.Width != 5 {
+		t.Errorf("expected spacer width 5 (12-2-2-1-2), got %d", children[0].Layout.Width)
+	}
+	if children[1].Layout.X != 8 || children[1].Children[0].Layout.X != 8 {
+		t.Errorf("expected following subtree shifted to X=8, got %d and %d",
+			children[1].Layout.X, children[1].Children[0].Layout.X)
+	}
+}
+
+func TestCalculateLayout_SetsParentPointers(t *testing.T) {
+	leaf := Text("leaf")
+	root := Box(BoxProps{Key: "root"}, Box(BoxProps{Key: "inner"}, leaf), Text("sibling"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+
+	inner := tree.Children[0]
+	leafTree := inner.Children[0]
+	if tree.Parent() != nil {
+		t.Error("expected root to have no parent")
+	}
+	if inner.Parent() != tree || tree.Children[1].Parent() != tree {
+		t.Error("expected children of root to point at root")
+	}
+	if leafTree.Parent() != inner {
+		t.Error("expected leaf to point at inner box")
+	}
+}
+
+func TestLayoutTree_RootAndDepth(t *testing.T) {
+	root := Box(BoxProps{}, Box(BoxProps{}, Text("leaf")))
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+	leaf := tree.Children[0].Children[0]
+
+	if leaf.Root() != tree || tree.Root() != tree {
+		t.Error("expected Root to return the top of the tree")
+	}
+	if tree.Depth() != 0 || tree.Children[0].Depth() != 1 || leaf.Depth() != 2 {
+		t.Errorf("unexpected depths %d, %d, %d", tree.Depth(), tree.Children[0].Depth(), leaf.Depth())
+	}
+}
+
+func TestLayoutTree_HandBuilt_HasNoParent(t *testing.T) {
+	tree := &LayoutTree{Component: Text("x")}
+
+	if tree.Parent() != nil || tree.Root() != tree || tree.Depth() != 0 {
+		t.Error("expected a hand-built node to be its own root")
+	}
+}
+
+func TestLayoutEngine_HitTest_ReturnsDeepestNodeAtPoint(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	tree := engine.CalculateLayout(Box(BoxProps{Direction: Row, Key: "root"},
+		Box(BoxProps{Key: "left"}, Text("Left")),
+		Box(BoxProps{Key: "right"}, Text("Right")),
+	))
+
+	hit := engine.HitTest(tree, 5, 0)
+
+	if hit == nil || len(hit.Children) != 0 {
+		t.Fatalf("expected a leaf node at (5, 0), got %v", hit)
+	}
+	if hit.Parent().Component.Key() != "right" {
+		t.Errorf("expected the text inside %q, got parent %q", "right", hit.Parent().Component.Key())
+	}
+}
+
+func TestLayoutEngine_HitTest_OutsideTree_ReturnsNil(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	tree := engine.CalculateLayout(Text("Hello"))
+
+	if hit := engine.HitTest(tree, 10, 0); hit != nil {
+		t.Errorf("expected nil outside the tree, got %v", hit.Layout)
+	}
+	if hit := engine.HitTest(nil, 0, 0); hit != nil {
+		t.Errorf("expected nil for a nil tree, got %v", hit.Layout)
+	}
+}
+
+func TestLayoutEngine_DimensionFill_SingleChildTakesRemainingWidth(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Row, Width: DimensionFixed(30)},
+		Box(BoxProps{Width: DimensionFixed(10)}, Text("Side")),
+		Box(BoxProps{Width: DimensionFill()}, Text("Main")),
+	))
+
+	main := tree.Children[1].Layout
+	if main.X != 10 || main.Width != 20 {
+		t.Errorf("expected fill child at X=10 with width 20, got X=%d width=%d", main.X, main.Width)
+	}
+}
+
+func TestLayoutEngine_DimensionFill_MultipleChildrenSplitEqually(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Row, Width: DimensionFixed(31)},
+		Box(BoxProps{Width: DimensionFixed(10)}),
+		Box(BoxProps{Width: DimensionFill()}),
+		Box(BoxProps{Width: DimensionFill()}),
+		Box(BoxProps{Width: DimensionFill()}),
+	))
+
+	widths := []int{tree.Children[1].Layout.Width, tree.Children[2].Layout.Width, tree.Children[3].Layout.Width}
+	if widths[0] != 7 || widths[1] != 7 || widths[2] != 7 {
+		t.Errorf("expected fill widths [7 7 7], got %v", widths)
+	}
+	if x := tree.Children[3].Layout.X; x != 24 {
+		t.Errorf("expected last fill child at X=24, got %d", x)
+	}
+}
+
+func TestLayoutEngine_DimensionFill_RoundingRemainderGoesToFillChildren(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Row, Width: DimensionFixed(11)},
+		Box(BoxProps{Width: DimensionFill()}),
+		Box(BoxProps{Width: DimensionFill()}),
+	))
+
+	first, second := tree.Children[0].Layout.Width, tree.Children[1].Layout.Width
+	if first+second != 11 || first-second > 1 {
+		t.Errorf("expected widths splitting 11 cells, got %d and %d", first, second)
+	}
+}
+
+func TestLayoutEngine_DimensionFill_ColumnWithFixedAndAutoSiblings(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Column, Height: DimensionFixed(12), Width: DimensionFixed(20)},
+		Text("Header"),
+		Box(BoxProps{Height: DimensionFill(), Width: DimensionFill()}, Text("Body")),
+		Box(BoxProps{Height: DimensionFixed(2)}, Text("Footer")),
+	))
+
+	body := tree.Children[1].Layout
+	if body.Y != 1 || body.Height != 9 {
+		t.Errorf("expected body at Y=1 with height 9, got Y=%d height=%d", body.Y, body.Height)
+	}
+	if body.Width != 20 {
+		t.Errorf("expected cross-axis fill to stretch to width 20, got %d", body.Width)
+	}
+	if footer := tree.Children[2].Layout; footer.Y != 10 {
+		t.Errorf("expected footer at Y=10, got %d", footer.Y)
+	}
+}
+
+func TestLayoutEngine_DimensionFill_AutoParent_SizesToContent(t *testing.T) {
+	tree := NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Direction: Row},
+		Box(BoxProps{Width: DimensionFill()}, Text("Main")),
+	))
+
+	if width := tree.Children[0].Layout.Width; width != 4 {
+		t.Errorf("expected content width 4, got %d", width)
+	}
+}
+
+func TestLayoutEngine_TerminalDimensions_ReturnConstructorValues(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+
+	if engine.TerminalWidth() != 80 || engine.TerminalHeight() != 24 {
+		t.Errorf("expected 80x24, got %dx%d", engine.TerminalWidth(), engine.TerminalHeight())
+	}
+}
+
+func TestLayoutEngine_SetDimensions_RecalculatesWithNewSize(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	root := Box(BoxProps{Width: DimensionPercent(50), Height: DimensionPercent(100)}, Text("Hi"))
+	before := engine.CalculateLayout(root)
+
+	engine.SetDimensions(120, 40)
+	after := engine.CalculateLayout(root)
+
+	if engine.TerminalWidth() != 120 || engine.TerminalHeight() != 40 {
+		t.Errorf("expected 120x40, got %dx%d", engine.TerminalWidth(), engine.TerminalHeight())
+	}
+	if before.Layout.Width != 40 || before.Layout.Height != 24 {
+		t.Errorf("expected the earlier tree to keep 40x24, got %dx%d", before.Layout.Width, before.Layout.Height)
+	}
+	if after.Layout.Width != 60 || after.Layout.Height != 40 {
+		t.Errorf("expected 60x40 after SetDimensions, got %dx%d", after.Layout.Width, after.Layout.Height)
+	}
+}
+
+func threeLevelTree() *LayoutTree {
+	return NewLayoutEngine(80, 24).CalculateLayout(Box(BoxProps{Key: "root", Direction: Row},
+		Box(BoxProps{Key: "left"}, Text("A", TextProps{Key: "a"}), Text("B", TextProps{Key: "b"})),
+		Box(BoxProps{Key: "right"}, Text("C", TextProps{Key: "c"})),
+	))
+}
+
+func TestLayoutTree_Visit_CallsEveryNodeInPreOrder(t *testing.T) {
+	var keys []string
+	threeLevelTree().Visit(func(node *LayoutTree) bool {
+		keys = append(keys, node.Component.Key())
+		return true
+	})
+
+	expected := "root,left,a,b,right,c"
+	if got := strings.Join(keys, ","); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestLayoutTree_Visit_StopsWhenFnReturnsFalse(t *testing.T) {
+	var keys []string
+	threeLevelTree().Visit(func(node *LayoutTree) bool {
+		keys = append(keys, node.Component.Key())
+		return node.Component.Key() != "a"
+	})
+
+	expected := "root,left,a"
+	if got := strings.Join(keys, ","); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestLayoutTree_Find_ReturnsNodeWithKey(t *testing.T) {
+	tree := threeLevelTree()
+
+	node := tree.Find("c")
+
+	if node == nil {
+		t.Fatal("expected to find node c")
+	}
+	if node.Parent().Component.Key() != "right" {
+		t.Errorf("expected c inside right, got parent %q", node.Parent().Component.Key())
+	}
+	if tree.Find("missing") != nil {
+		t.Error("expected nil for a missing key")
+	}
+}
+
+func TestLayoutEngine_RowWithJustifySpaceEvenly_SpacesChildrenEqually(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	root := Box(BoxProps{Direction: Row, Width: DimensionFixed(17), JustifyContent: JustifySpaceEvenly},
+		Text("abc"), Text("def"), Text("ghi"))
+
+	tree := engine.CalculateLayout(root)
+
+	for i, want := range []int{2, 7, 12} {
+		if got := tree.Children[i].Layout.X; got != want {
+			t.Errorf("child %d X: expected %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestLayoutEngine_ColumnWithJustifySpaceEvenly_SpacesInsideBorder(t *testing.T) {
+	engine := NewLayoutEngine(80, 24)
+	nested := Text("nested")
+	root := Box(BoxProps{Direction: Column, Height: DimensionFixed(12), Border: BorderSingle, JustifyContent: JustifySpaceEvenly},
+		Text("first"), Box(BoxProps{}, nested))
+
+	tree := engine.CalculateLayout(root)
+
+	if got := tree.Children[0].Layout.Y; got != 3 {
+		t.Errorf("first child Y: expected 3, got %d", got)
+	}
+	if got := tree.Children[1].Layout.Y; got != 6 {
+		t.Errorf("second child Y: expected 6, got %d", got)
+	}
+	if got := tree.Children[1].Children[0].Layout.Y; got != 6 {
+		t.Errorf("nested child Y: expected 6 (moved with its parent), got %d", got)
+	}
+}
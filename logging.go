@@ -0,0 +1,67 @@
+package runetui
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// WithSlogHandler routes structured logs into the static zone.
+// Each log record is formatted as a single logfmt line (without the time
+// attribute) and appended above the dynamic content. Use AppSlogLogger to
+// obtain a logger backed by this handler.
+func WithSlogHandler() AppOption {
+	return func(a *App) {
+		a.slogHandler = newStaticHandler(staticWriter{app: a})
+	}
+}
+
+// AppSlogLogger returns a logger that writes to the app's static zone.
+// If the app was not created with WithSlogHandler, it returns slog.Default().
+func AppSlogLogger(app *App) *slog.Logger {
+	if app.slogHandler == nil {
+		return slog.Default()
+	}
+	return slog.New(app.slogHandler)
+}
+
+// renderLogger returns the logger for problems found while a frame renders.
+// With WithSlogHandler it writes to the static zone without requesting a
+// re-render, which would render and log again; otherwise it is slog.Default().
+func (a *App) renderLogger() *slog.Logger {
+	if a.slogHandler == nil {
+		return slog.Default()
+	}
+	return slog.New(newStaticHandler(staticWriter{app: a, quiet: true}))
+}
+
+// newStaticHandler creates a text handler whose output is appended to the static zone.
+func newStaticHandler(w staticWriter) slog.Handler {
+	return slog.NewTextHandler(w, &slog.HandlerOptions{
+		ReplaceAttr: dropTime,
+	})
+}
+
+// dropTime removes the top-level time attribute from log records.
+func dropTime(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) == 0 && attr.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+	return attr
+}
+
+// staticWriter is an io.Writer that appends everything written to the static zone.
+// A quiet writer skips the re-render, for writes made while a frame is rendering.
+type staticWriter struct {
+	app   *App
+	quiet bool
+}
+
+// Write appends p to the static zone and triggers a re-render unless w is quiet.
+func (w staticWriter) Write(p []byte) (int, error) {
+	if w.quiet {
+		w.app.staticManager.Append(strings.Split(strings.TrimSuffix(string(p), "\n"), "\n")...)
+		return len(p), nil
+	}
+	w.app.Printf("%s", p)
+	return len(p), nil
+}
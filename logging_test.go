@@ -0,0 +1,73 @@
+package runetui
+
+import (
+	"log/slog"
+	"testing"
+)
+
+func TestWithSlogHandler_SetsHandler(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+
+	if app.slogHandler == nil {
+		t.Fatal("expected slog handler to be set")
+	}
+}
+
+func TestAppSlogLogger_WithoutHandler_ReturnsDefault(t *testing.T) {
+	app := New(func() Component { return Text("Hello") })
+
+	logger := AppSlogLogger(app)
+
+	if logger != slog.Default() {
+		t.Error("expected slog.Default() when WithSlogHandler is not used")
+	}
+}
+
+func TestAppSlogLogger_Info_AppendsRecordToStaticZone(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+
+	AppSlogLogger(app).Info("file saved", "path", "a.txt", "bytes", 42)
+
+	got := app.staticManager.RenderStatic()
+	expected := `level=INFO msg="file saved" path=a.txt bytes=42`
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAppSlogLogger_WithAttrsAndGroup_FormatsQualifiedKeys(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+
+	logger := AppSlogLogger(app).With("job", 7).WithGroup("req")
+	logger.Warn("slow", "ms", 250)
+
+	got := app.staticManager.RenderStatic()
+	expected := "level=WARN msg=slow job=7 req.ms=250"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAppSlogLogger_MultipleRecords_AppendOneLineEach(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+	logger := AppSlogLogger(app)
+
+	logger.Info("first")
+	logger.Error("second")
+
+	got := app.staticManager.RenderStatic()
+	expected := "level=INFO msg=first\nlevel=ERROR msg=second"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAppSlogLogger_DebugBelowDefaultLevel_IsDropped(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithSlogHandler())
+
+	AppSlogLogger(app).Debug("hidden")
+
+	if got := app.staticManager.RenderStatic(); got != "" {
+		t.Errorf("expected no output, got %q", got)
+	}
+}
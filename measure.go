@@ -9,12 +9,19 @@ import (
 // For Fixed dimensions, returns the fixed value.
 // For Auto dimensions, returns 0 (caller must provide intrinsic size).
 // For Percent dimensions, calculates percentage of available space.
+// For Clamp dimensions, clamps the wrapped dimension's non-zero value.
 func resolveDimension(dim Dimension, available int) int {
 	switch d := dim.(type) {
 	case dimensionFixed:
 		return d.Value()
 	case dimensionPercent:
 		return (available * d.Value()) / 100
+	case dimensionClamp:
+		resolved := resolveDimension(d.dim, available)
+		if resolved == 0 {
+			return 0
+		}
+		return max(d.min, min(resolved, d.max))
 	case dimensionAuto:
 		return 0
 	default:
@@ -23,6 +30,7 @@ func resolveDimension(dim Dimension, available int) int {
 }
 
 // measureText calculates the size of text based on content and wrap mode.
+// ANSI escape codes in pre-styled content do not count toward the width.
 func measureText(content string, wrap WrapMode, availableWidth int) Size {
 	if content == "" {
 		return Size{Width: 0, Height: 0}
@@ -33,7 +41,7 @@ func measureText(content string, wrap WrapMode, availableWidth int) Size {
 	width := 0
 
 	for _, line := range lines {
-		lineWidth := utf8.RuneCountInString(line)
+		lineWidth := utf8.RuneCountInString(StripANSI(line))
 		if lineWidth > width {
 			width = lineWidth
 		}
@@ -47,11 +55,11 @@ func measureText(content string, wrap WrapMode, availableWidth int) Size {
 		return Size{Width: availableWidth, Height: height}
 	}
 
-	if wrap == WrapWord || wrap == WrapChar {
+	if wrap == WrapWord || wrap == WrapChar || wrap == WrapOverflow {
 		if width > availableWidth && availableWidth > 0 {
 			totalRunes := 0
 			for _, line := range lines {
-				totalRunes += utf8.RuneCountInString(line)
+				totalRunes += utf8.RuneCountInString(StripANSI(line))
 			}
 			wrappedHeight := (totalRunes + availableWidth - 1) / availableWidth
 			return Size{Width: availableWidth, Height: wrappedHeight}
@@ -71,13 +79,35 @@ func spacingHeight(s Spacing) int {
 	return s.Top + s.Bottom
 }
 
-// borderSize returns the width and height added by a border.
-// Any border style except BorderNone adds 2 to width and 2 to height.
-func borderSize(style BorderStyle) (width, height int) {
-	if style == BorderNone {
-		return 0, 0
+// borderSize returns the width and height added by a box's border: one cell
+// for each side drawn.
+func borderSize(props BoxProps) (width, height int) {
+	top, right, bottom, left := borderSides(props)
+	return boolInt(left) + boolInt(right), boolInt(top) + boolInt(bottom)
+}
+
+// borderOffset returns how far a box's border moves its content right and down.
+func borderOffset(props BoxProps) (left, top int) {
+	t, _, _, l := borderSides(props)
+	return boolInt(l), boolInt(t)
+}
+
+// borderSides reports which sides of a box have a border. A Border style
+// draws all four; otherwise the individual BorderTop, BorderRight,
+// BorderBottom and BorderLeft flags apply.
+func borderSides(props BoxProps) (top, right, bottom, left bool) {
+	if props.Border != BorderNone {
+		return true, true, true, true
+	}
+	return props.BorderTop, props.BorderRight, props.BorderBottom, props.BorderLeft
+}
+
+// boolInt returns 1 for true and 0 for false.
+func boolInt(b bool) int {
+	if b {
+		return 1
 	}
-	return 2, 2
+	return 0
 }
 
 // applyConstraints applies min/max constraints to a size.
@@ -97,44 +127,51 @@ func applyConstraints(size Size, minWidth, minHeight, maxWidth, maxHeight int) S
 	return size
 }
 
-// measureBox calculates the size of a box including its children.
-func measureBox(props BoxProps, children []Component, availableWidth, availableHeight int) Size {
-	if len(children) == 0 {
-		return Size{Width: 0, Height: 0}
+// directionalMeasurer is implemented by components whose size depends on the
+// layout direction of their parent.
+type directionalMeasurer interface {
+	measureIn(direction Direction, availableWidth, availableHeight int) Size
+}
+
+// measureChild measures child as laid out inside a parent with the given direction.
+func measureChild(child Component, direction Direction, availableWidth, availableHeight int) Size {
+	if d, ok := child.(directionalMeasurer); ok {
+		return d.measureIn(direction, availableWidth, availableHeight)
 	}
+	return child.Measure(availableWidth, availableHeight)
+}
 
-	var totalWidth, totalHeight int
-	var maxWidth, maxHeight int
+// measureBox calculates the size of a box including its children.
+func measureBox(props BoxProps, children []Component, availableWidth, availableHeight int) Size {
+	// position is where the next child starts along the main axis and extent
+	// is the furthest any child reaches. They differ when a negative margin
+	// pulls a child back over a previous sibling.
+	var position, extent int
+	var crossSize int
 
 	for i, child := range children {
-		childSize := child.Measure(availableWidth, availableHeight)
+		childSize := measureChild(child, props.Direction, availableWidth, availableHeight)
 
+		if i > 0 {
+			position += childSpacing(props, children, i-1)
+		}
 		if props.Direction == Row {
-			totalWidth += childSize.Width
-			if i > 0 && props.Gap > 0 {
-				totalWidth += props.Gap
-			}
-			if childSize.Height > maxHeight {
-				maxHeight = childSize.Height
-			}
+			position += childSize.Width
+			crossSize = max(crossSize, childSize.Height)
 		} else {
-			totalHeight += childSize.Height
-			if i > 0 && props.Gap > 0 {
-				totalHeight += props.Gap
-			}
-			if childSize.Width > maxWidth {
-				maxWidth = childSize.Width
-			}
+			position += childSize.Height
+			crossSize = max(crossSize, childSize.Width)
 		}
+		extent = max(extent, position)
 	}
 
 	var width, height int
 	if props.Direction == Row {
-		width = totalWidth
-		height = maxHeight
+		width = extent
+		height = crossSize
 	} else {
-		width = maxWidth
-		height = totalHeight
+		width = crossSize
+		height = extent
 	}
 
 	width += spacingWidth(props.Padding)
@@ -143,9 +180,12 @@ func measureBox(props BoxProps, children []Component, availableWidth, availableH
 	width += spacingWidth(props.Margin)
 	height += spacingHeight(props.Margin)
 
-	borderWidth, borderHeight := borderSize(props.Border)
+	borderWidth, borderHeight := borderSize(props)
 	width += borderWidth
 	height += borderHeight
+	if props.Border != BorderNone && props.BorderTitle != "" {
+		width = max(width, borderTitleWidth(props.BorderTitle))
+	}
 
 	resolvedWidth := resolveDimension(props.Width, availableWidth)
 	if resolvedWidth > 0 {
@@ -157,6 +197,11 @@ func measureBox(props BoxProps, children []Component, availableWidth, availableH
 		height = resolvedHeight
 	}
 
+	if props.Overflow != OverflowVisible {
+		width = min(width, availableWidth)
+		height = min(height, availableHeight)
+	}
+
 	size := Size{Width: width, Height: height}
 	size = applyConstraints(size, props.MinWidth, props.MinHeight, props.MaxWidth, props.MaxHeight)
 
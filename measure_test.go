@@ -165,14 +165,14 @@ func TestSpacingHeight_WithSpacing_ReturnsTotalHeight(t *testing.T) {
 }
 
 func TestBorderSize_WithNoBorder_ReturnsZero(t *testing.T) {
-	width, height := borderSize(BorderNone)
+	width, height := borderSize(BoxProps{Border: BorderNone})
 	if width != 0 || height != 0 {
 		t.Errorf("expected 0,0 for no border, got %d,%d", width, height)
 	}
 }
 
 func TestBorderSize_WithBorder_ReturnsTwoByTwo(t *testing.T) {
-	width, height := borderSize(BorderSingle)
+	width, height := borderSize(BoxProps{Border: BorderSingle})
 	if width != 2 || height != 2 {
 		t.Errorf("expected 2,2 for border, got %d,%d", width, height)
 	}
@@ -376,3 +376,182 @@ func TestMeasureBox_ComplexScenario_AllFeaturesWork(t *testing.T) {
 		t.Errorf("expected width %d (1+1+2gap+2pad+2mar+2bor), got %d", expected, size.Width)
 	}
 }
+
+func TestMeasureBox_OverflowHidden_ClampsToAvailableSpace(t *testing.T) {
+	props := BoxProps{Direction: Column, Overflow: OverflowHidden}
+	children := []Component{
+		Text("a very long line of text"),
+		Text("b"),
+		Text("c"),
+	}
+	size := measureBox(props, children, 10, 2)
+	if size.Width != 10 {
+		t.Errorf("expected width clamped to 10, got %d", size.Width)
+	}
+	if size.Height != 2 {
+		t.Errorf("expected height clamped to 2, got %d", size.Height)
+	}
+}
+
+func TestMeasureBox_OverflowVisible_DoesNotClamp(t *testing.T) {
+	props := BoxProps{Direction: Column}
+	children := []Component{
+		Text("a very long line of text"),
+		Text("b"),
+		Text("c"),
+	}
+	size := measureBox(props, children, 10, 2)
+	if size.Width != 24 {
+		t.Errorf("expected natural width 24, got %d", size.Width)
+	}
+	if size.Height != 3 {
+		t.Errorf("expected natural height 3, got %d", size.Height)
+	}
+}
+
+func TestResolveDimension_Clamp_BelowMin_ReturnsMin(t *testing.T) {
+	dim := DimensionClamp(DimensionPercent(10), 20, 40)
+	if got := resolveDimension(dim, 100); got != 20 {
+		t.Errorf("expected 20, got %d", got)
+	}
+}
+
+func TestResolveDimension_Clamp_AboveMax_ReturnsMax(t *testing.T) {
+	dim := DimensionClamp(DimensionPercent(50), 20, 40)
+	if got := resolveDimension(dim, 100); got != 40 {
+		t.Errorf("expected 40, got %d", got)
+	}
+}
+
+func TestResolveDimension_Clamp_WithinRange_ReturnsValue(t *testing.T) {
+	dim := DimensionClamp(DimensionFixed(30), 20, 40)
+	if got := resolveDimension(dim, 100); got != 30 {
+		t.Errorf("expected 30, got %d", got)
+	}
+}
+
+func TestResolveDimension_Clamp_AtBoundaries_ReturnsBoundary(t *testing.T) {
+	if got := resolveDimension(DimensionClamp(DimensionFixed(20), 20, 40), 100); got != 20 {
+		t.Errorf("expected 20, got %d", got)
+	}
+	if got := resolveDimension(DimensionClamp(DimensionFixed(40), 20, 40), 100); got != 40 {
+		t.Errorf("expected 40, got %d", got)
+	}
+}
+
+func TestResolveDimension_ClampAuto_StaysAuto(t *testing.T) {
+	dim := DimensionClamp(DimensionAuto(), 20, 40)
+	if got := resolveDimension(dim, 100); got != 0 {
+		t.Errorf("expected 0 for auto, got %d", got)
+	}
+}
+
+func TestMeasureBox_WithClampedPercentWidth_UsesClampedValue(t *testing.T) {
+	props := BoxProps{Width: DimensionClamp(DimensionPercent(100), 0, 30)}
+	size := measureBox(props, []Component{Text("hi")}, 80, 24)
+	if size.Width != 30 {
+		t.Errorf("expected width 30, got %d", size.Width)
+	}
+}
+
+func TestMeasureBox_NegativeTopMargin_ParentIncludesOverlap(t *testing.T) {
+	tall := Box(BoxProps{}, Text("1"), Text("2"), Text("3"))
+	pulledUp := Box(BoxProps{Margin: Spacing{Top: -2}}, Text("x"))
+
+	size := measureBox(BoxProps{Direction: Column}, []Component{tall, pulledUp}, 80, 24)
+
+	if size.Height != 3 {
+		t.Errorf("expected height 3 covering the overlapped sibling, got %d", size.Height)
+	}
+}
+
+func TestMeasureBox_NegativeTopMargin_ExtendsPastSibling(t *testing.T) {
+	header := Text("header")
+	pulledUp := Box(BoxProps{Margin: Spacing{Top: -1}}, Text("a"), Text("b"), Text("c"))
+
+	size := measureBox(BoxProps{Direction: Column}, []Component{header, pulledUp}, 80, 24)
+
+	if size.Height != 3 {
+		t.Errorf("expected height 3, got %d", size.Height)
+	}
+}
+
+func TestMeasureBox_NegativeLeftMargin_ParentIncludesOverlap(t *testing.T) {
+	wide := Text("abcdef")
+	pulledLeft := Box(BoxProps{Margin: Spacing{Left: -4}}, Text("x"))
+
+	size := measureBox(BoxProps{Direction: Row}, []Component{wide, pulledLeft}, 80, 24)
+
+	if size.Width != 6 {
+		t.Errorf("expected width 6, got %d", size.Width)
+	}
+}
+
+func TestLayout_NegativeTopMargin_ChildOverlapsPreviousSibling(t *testing.T) {
+	root := Box(BoxProps{Direction: Column},
+		Box(BoxProps{}, Text("1"), Text("2"), Text("3")),
+		Box(BoxProps{Margin: Spacing{Top: -2}}, Text("x")),
+	)
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(root)
+
+	if tree.Layout.Height != 3 {
+		t.Errorf("expected parent height 3, got %d", tree.Layout.Height)
+	}
+	if y := tree.Children[1].Layout.Y; y != 1 {
+		t.Errorf("expected pulled-up child at Y=1, got %d", y)
+	}
+}
+
+func TestMeasureText_WithANSIContent_IgnoresEscapeCodes(t *testing.T) {
+	size := measureText("\x1b[1mBold\x1b[0m\n\x1b[31mab\x1b[0m", WrapNone, 80)
+
+	if size.Width != 4 || size.Height != 2 {
+		t.Errorf("expected 4x2, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestMeasureText_WrapWithANSIContent_CountsVisibleRunes(t *testing.T) {
+	size := measureText("\x1b[1mabcdef\x1b[0m", WrapChar, 3)
+
+	if size.Height != 2 {
+		t.Errorf("expected 2 wrapped lines, got %d", size.Height)
+	}
+}
+
+func TestMeasureBox_NoChildren_UsesFixedDimensions(t *testing.T) {
+	size := measureBox(BoxProps{Width: DimensionFixed(4), Height: DimensionFixed(2)}, nil, 80, 24)
+
+	if size.Width != 4 || size.Height != 2 {
+		t.Errorf("expected 4x2, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestMeasureBox_NoChildren_IncludesPaddingMarginAndBorder(t *testing.T) {
+	props := BoxProps{
+		Padding: SpacingCSSLike(1, 2, 1, 2),
+		Margin:  SpacingXY(1, 0),
+		Border:  BorderSingle,
+	}
+
+	size := measureBox(props, nil, 80, 24)
+
+	if size.Width != 8 || size.Height != 4 {
+		t.Errorf("expected 8x4 (padding 4x2 + margin 2x0 + border 2x2), got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestMeasureBox_SingleChild_AddsNoGap(t *testing.T) {
+	size := measureBox(BoxProps{Direction: Row, Gap: 3}, []Component{Text("abc")}, 80, 24)
+
+	if size.Width != 3 {
+		t.Errorf("expected width 3 without gap, got %d", size.Width)
+	}
+}
+
+func TestBorderSize_IndividualSides_CountsEnabledSides(t *testing.T) {
+	width, height := borderSize(BoxProps{BorderTop: true, BorderLeft: true, BorderRight: true})
+	if width != 2 || height != 1 {
+		t.Errorf("expected 2,1 for top, left and right, got %d,%d", width, height)
+	}
+}
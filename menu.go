@@ -0,0 +1,168 @@
+package runetui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MenuItem is one entry of a Menu. Action, if set, is called when the item is
+// selected and its command is dispatched alongside MenuSelectMsg.
+type MenuItem struct {
+	Label    string
+	Shortcut string
+	Action   func() tea.Cmd
+	Disabled bool
+}
+
+// MenuProps defines properties for the Menu component.
+// FocusedStyle defaults to bold text when left empty.
+type MenuProps struct {
+	FocusedIndex  int
+	Width         Dimension
+	FocusedStyle  TextProps
+	NormalStyle   TextProps
+	DisabledStyle TextProps
+	Key           string
+}
+
+func (MenuProps) isProps() {}
+
+// MenuFocusMsg is dispatched when the user moves focus to the item at Index.
+// The application owns FocusedIndex and should store Index in its UpdateFunc.
+type MenuFocusMsg struct {
+	Index int
+}
+
+// MenuSelectMsg is dispatched when the user selects the item at Index.
+type MenuSelectMsg struct {
+	Index int
+}
+
+// menu is the private implementation of the Menu component.
+type menu struct {
+	props MenuProps
+	items []MenuItem
+	body  Component
+}
+
+// Menu creates a vertical navigation list with one item per line. Shortcuts
+// are shown after their label, and the focused and disabled items use their
+// own styles. Use HandleMenuKey in the UpdateFunc for keyboard control.
+//
+// Example:
+//
+//	Menu(MenuProps{FocusedIndex: focused}, []MenuItem{
+//	    {Label: "Install", Shortcut: "i"},
+//	    {Label: "Remove", Shortcut: "r", Disabled: !installed},
+//	})
+func Menu(props MenuProps, items []MenuItem) Component {
+	if props.FocusedStyle == (TextProps{}) {
+		props.FocusedStyle = TextProps{Bold: true}
+	}
+
+	rows := make([]Component, len(items))
+	for i, item := range items {
+		rows[i] = Text(menuLine(item), menuStyle(props, item, i))
+	}
+
+	return &menu{
+		props: props,
+		items: items,
+		body:  Box(BoxProps{Direction: Column, Width: props.Width}, rows...),
+	}
+}
+
+// menuLine returns the label followed by the shortcut, if any.
+func menuLine(item MenuItem) string {
+	if item.Shortcut == "" {
+		return item.Label
+	}
+	return item.Label + "  " + item.Shortcut
+}
+
+// menuStyle picks the text style for the item at index.
+func menuStyle(props MenuProps, item MenuItem, index int) TextProps {
+	switch {
+	case item.Disabled:
+		return props.DisabledStyle
+	case index == props.FocusedIndex:
+		return props.FocusedStyle
+	default:
+		return props.NormalStyle
+	}
+}
+
+// HandleMenuKey returns the command for a key press on the menu: up/k and
+// down/j dispatch MenuFocusMsg for the previous or next enabled item, and
+// enter dispatches MenuSelectMsg together with the focused item's Action.
+// It returns nil for other messages, for disabled items and at the ends.
+func HandleMenuKey(props MenuProps, items []MenuItem, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "up", "k":
+		return menuFocusCmd(items, props.FocusedIndex, -1)
+	case "down", "j":
+		return menuFocusCmd(items, props.FocusedIndex, 1)
+	case "enter":
+		return menuSelectCmd(items, props.FocusedIndex)
+	}
+	return nil
+}
+
+// menuFocusCmd moves focus by step to the nearest enabled item.
+func menuFocusCmd(items []MenuItem, from, step int) tea.Cmd {
+	for i := from + step; i >= 0 && i < len(items); i += step {
+		if !items[i].Disabled {
+			index := i
+			return func() tea.Msg { return MenuFocusMsg{Index: index} }
+		}
+	}
+	return nil
+}
+
+// menuSelectCmd selects the item at index if it exists and is enabled.
+func menuSelectCmd(items []MenuItem, index int) tea.Cmd {
+	if index < 0 || index >= len(items) || items[index].Disabled {
+		return nil
+	}
+	selected := func() tea.Msg { return MenuSelectMsg{Index: index} }
+	if items[index].Action == nil {
+		return selected
+	}
+	return tea.Batch(selected, items[index].Action())
+}
+
+// Render generates one line per item.
+func (m *menu) Render(layout Layout) string {
+	return m.body.Render(layout)
+}
+
+// Children returns the item lines.
+func (m *menu) Children() []Component {
+	return m.body.Children()
+}
+
+// Key returns the unique identifier for this component.
+func (m *menu) Key() string {
+	return m.props.Key
+}
+
+func (m *menu) label() string {
+	return "menu"
+}
+
+// Measure returns one line per item. The width is the resolved Width prop, or
+// the widest item line when Width is auto.
+func (m *menu) Measure(availableWidth, availableHeight int) Size {
+	size := Size{Height: len(m.items)}
+	if width := resolveDimension(m.props.Width, availableWidth); width > 0 {
+		size.Width = width
+		return size
+	}
+	for _, row := range m.body.Children() {
+		size.Width = max(size.Width, row.Measure(availableWidth, availableHeight).Width)
+	}
+	return size
+}
@@ -0,0 +1,140 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func sampleMenuItems() []MenuItem {
+	return []MenuItem{
+		{Label: "Install", Shortcut: "i"},
+		{Label: "Remove", Shortcut: "r", Disabled: true},
+		{Label: "Quit"},
+	}
+}
+
+func TestMenu_Render_ShowsLabelsAndShortcuts(t *testing.T) {
+	m := Menu(MenuProps{}, sampleMenuItems())
+
+	output := StripANSI(m.Render(Layout{Width: 12, Height: 3}))
+
+	lines := strings.Split(output, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d: %q", len(lines), output)
+	}
+	if !strings.HasPrefix(lines[0], "Install  i") || !strings.HasPrefix(lines[2], "Quit") {
+		t.Errorf("unexpected lines %q", lines)
+	}
+}
+
+func TestMenu_FocusedItem_UsesFocusedStyle(t *testing.T) {
+	items := sampleMenuItems()
+	m := Menu(MenuProps{FocusedIndex: 2, FocusedStyle: TextProps{Color: "#FF0000"}}, items)
+
+	rows := m.Children()
+
+	if rows[2].(*text).props.Color != "#FF0000" || rows[0].(*text).props.Color != "" {
+		t.Error("expected only the focused item to use FocusedStyle")
+	}
+}
+
+func TestMenu_DefaultFocusedStyle_IsBold(t *testing.T) {
+	m := Menu(MenuProps{DisabledStyle: TextProps{Italic: true}}, sampleMenuItems())
+
+	rows := m.Children()
+
+	if !rows[0].(*text).props.Bold {
+		t.Error("expected focused item to default to bold")
+	}
+	if !rows[1].(*text).props.Italic {
+		t.Error("expected disabled item to use DisabledStyle")
+	}
+}
+
+func TestMenu_Measure_ReturnsItemCountHeight(t *testing.T) {
+	auto := Menu(MenuProps{Key: "nav"}, sampleMenuItems()).Measure(80, 24)
+	fixed := Menu(MenuProps{Width: DimensionFixed(20)}, sampleMenuItems()).Measure(80, 24)
+
+	if auto.Height != 3 || auto.Width != len("Install  i") {
+		t.Errorf("expected 10x3, got %dx%d", auto.Width, auto.Height)
+	}
+	if fixed.Width != 20 || fixed.Height != 3 {
+		t.Errorf("expected 20x3, got %dx%d", fixed.Width, fixed.Height)
+	}
+}
+
+func TestMenu_Key(t *testing.T) {
+	if key := Menu(MenuProps{Key: "nav"}, nil).Key(); key != "nav" {
+		t.Errorf("expected key 'nav', got %q", key)
+	}
+}
+
+func TestHandleMenuKey_Arrows_SkipDisabledItems(t *testing.T) {
+	items := sampleMenuItems()
+
+	down := HandleMenuKey(MenuProps{FocusedIndex: 0}, items, tea.KeyMsg{Type: tea.KeyDown})
+	up := HandleMenuKey(MenuProps{FocusedIndex: 2}, items, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+
+	if msg := down(); msg != (MenuFocusMsg{Index: 2}) {
+		t.Errorf("expected focus on 2, got %#v", msg)
+	}
+	if msg := up(); msg != (MenuFocusMsg{Index: 0}) {
+		t.Errorf("expected focus on 0, got %#v", msg)
+	}
+}
+
+func TestHandleMenuKey_AtEnds_ReturnsNil(t *testing.T) {
+	items := sampleMenuItems()
+
+	if cmd := HandleMenuKey(MenuProps{FocusedIndex: 0}, items, tea.KeyMsg{Type: tea.KeyUp}); cmd != nil {
+		t.Error("expected nil moving up from the first item")
+	}
+	if cmd := HandleMenuKey(MenuProps{FocusedIndex: 2}, items, tea.KeyMsg{Type: tea.KeyDown}); cmd != nil {
+		t.Error("expected nil moving down from the last item")
+	}
+}
+
+func TestHandleMenuKey_Enter_DispatchesSelectAndAction(t *testing.T) {
+	actionCalled := false
+	items := []MenuItem{{Label: "Run", Action: func() tea.Cmd {
+		actionCalled = true
+		return func() tea.Msg { return "ran" }
+	}}}
+
+	cmd := HandleMenuKey(MenuProps{}, items, tea.KeyMsg{Type: tea.KeyEnter})
+
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("expected a batch of 2 commands, got %#v", cmd())
+	}
+	if msg := batch[0](); msg != (MenuSelectMsg{Index: 0}) {
+		t.Errorf("expected MenuSelectMsg{Index: 0}, got %#v", msg)
+	}
+	if !actionCalled || batch[1]() != "ran" {
+		t.Error("expected item action to be dispatched")
+	}
+}
+
+func TestHandleMenuKey_Enter_WithoutAction_DispatchesSelect(t *testing.T) {
+	cmd := HandleMenuKey(MenuProps{FocusedIndex: 2}, sampleMenuItems(), tea.KeyMsg{Type: tea.KeyEnter})
+
+	if msg := cmd(); msg != (MenuSelectMsg{Index: 2}) {
+		t.Errorf("expected MenuSelectMsg{Index: 2}, got %#v", msg)
+	}
+}
+
+func TestHandleMenuKey_EnterOnDisabledOrOther_ReturnsNil(t *testing.T) {
+	items := sampleMenuItems()
+
+	if cmd := HandleMenuKey(MenuProps{FocusedIndex: 1}, items, tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("expected nil selecting a disabled item")
+	}
+	if cmd := HandleMenuKey(MenuProps{FocusedIndex: 5}, items, tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("expected nil selecting out of range")
+	}
+	if cmd := HandleMenuKey(MenuProps{}, items, tea.WindowSizeMsg{}); cmd != nil {
+		t.Error("expected nil for non-key messages")
+	}
+}
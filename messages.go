@@ -193,7 +193,8 @@ package runetui
 // 1. Use Bubble Tea's built-in message types when possible:
 //    - tea.KeyMsg for keyboard input
 //    - tea.MouseMsg for mouse events
-//    - tea.WindowSizeMsg for terminal resize
+//    - tea.WindowSizeMsg for terminal resize (delivered after the layout
+//      engine has been resized, so rootFunc renders with the new size)
 //
 // 2. Define custom message types for domain events:
 //    - struct{} for simple events (incrementMsg, saveMsg)
@@ -277,6 +278,18 @@ package runetui
 //	// Multiple commands
 //	return tea.Batch(cmd1, cmd2, cmd3)
 //
+// Composing Update Functions
+//
+// The runetui/middleware package wraps an UpdateFunc with reusable behavior
+// (Chain, Filter, Debounce, Throttle, Log, Recover, Timeout):
+//
+//	update := middleware.Chain(
+//	    middleware.Recover(func(v interface{}) { log.Print(v) }),
+//	    middleware.Throttle(100*time.Millisecond, isMouseMsg),
+//	)(updateFunc)
+//
+//	app := New(rootFunc, WithUpdate(update))
+//
 // Common Patterns
 //
 // Conditional rendering based on state:
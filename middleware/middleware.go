@@ -0,0 +1,177 @@
+// Package middleware provides reusable combinators for RuneTUI update functions.
+//
+// A Middleware wraps a runetui.UpdateFunc and returns a new one, so cross-cutting
+// behavior such as logging, rate limiting or panic recovery can be layered on top
+// of application logic without touching it.
+//
+// Example usage:
+//
+//	update := middleware.Chain(
+//	    middleware.Recover(func(v interface{}) { log.Printf("update panicked: %v", v) }),
+//	    middleware.Log(logger),
+//	    middleware.Filter(func(msg tea.Msg) bool {
+//	        _, isMouse := msg.(tea.MouseMsg)
+//	        return !isMouse
+//	    }),
+//	)(updateFunc)
+//
+//	app := runetui.New(rootFunc, runetui.WithUpdate(update))
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// now is the clock used by time-based middleware. Tests may replace it.
+var now = time.Now
+
+// Middleware wraps an UpdateFunc with additional behavior.
+type Middleware func(next runetui.UpdateFunc) runetui.UpdateFunc
+
+// Chain composes middleware into one. The first middleware is the outermost,
+// so it sees each message before the ones that follow it.
+func Chain(middleware ...Middleware) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		for i := len(middleware) - 1; i >= 0; i-- {
+			next = middleware[i](next)
+		}
+		return next
+	}
+}
+
+// Filter forwards only the messages for which keep returns true.
+// Dropped messages produce no command.
+func Filter(keep func(msg tea.Msg) bool) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		return func(msg tea.Msg) tea.Cmd {
+			if !keep(msg) {
+				return nil
+			}
+			return next(msg)
+		}
+	}
+}
+
+// debouncedMsg carries a delayed message back to the debouncer that scheduled it.
+type debouncedMsg struct {
+	owner *debouncer
+	seq   int
+	msg   tea.Msg
+}
+
+// debouncer tracks the most recent matching message for Debounce.
+type debouncer struct {
+	seq int
+}
+
+// Debounce delays matching messages by d and forwards only the last one
+// received within that window. Messages that do not match pass through immediately.
+func Debounce(d time.Duration, match func(msg tea.Msg) bool) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		state := &debouncer{}
+		return func(msg tea.Msg) tea.Cmd {
+			if dm, ok := msg.(debouncedMsg); ok && dm.owner == state {
+				if dm.seq != state.seq {
+					return nil
+				}
+				return next(dm.msg)
+			}
+			if !match(msg) {
+				return next(msg)
+			}
+			state.seq++
+			pending := debouncedMsg{owner: state, seq: state.seq, msg: msg}
+			return tea.Tick(d, func(time.Time) tea.Msg {
+				return pending
+			})
+		}
+	}
+}
+
+// Throttle forwards at most one matching message per interval d and drops
+// the rest. Messages that do not match pass through immediately.
+func Throttle(d time.Duration, match func(msg tea.Msg) bool) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		var last time.Time
+		return func(msg tea.Msg) tea.Cmd {
+			if !match(msg) {
+				return next(msg)
+			}
+			current := now()
+			if !last.IsZero() && current.Sub(last) < d {
+				return nil
+			}
+			last = current
+			return next(msg)
+		}
+	}
+}
+
+// Log records every message at debug level before forwarding it.
+func Log(logger *slog.Logger) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		return func(msg tea.Msg) tea.Cmd {
+			logger.Debug("update", "msg", fmt.Sprintf("%T", msg), "value", fmt.Sprintf("%v", msg))
+			return next(msg)
+		}
+	}
+}
+
+// Recover stops a panic in the wrapped UpdateFunc from crashing the app.
+// The panic value is passed to fallback and no command is returned.
+func Recover(fallback func(v interface{})) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		return func(msg tea.Msg) (cmd tea.Cmd) {
+			defer func() {
+				if v := recover(); v != nil {
+					fallback(v)
+					cmd = nil
+				}
+			}()
+			return next(msg)
+		}
+	}
+}
+
+// Timeout bounds the commands returned by the wrapped UpdateFunc.
+// If a command does not produce a message within d, the message from
+// fallback is delivered instead. A nil fallback drops the late message.
+func Timeout(d time.Duration, fallback tea.Cmd) Middleware {
+	return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+		return func(msg tea.Msg) tea.Cmd {
+			cmd := next(msg)
+			if cmd == nil {
+				return nil
+			}
+			return withTimeout(cmd, d, fallback)
+		}
+	}
+}
+
+// withTimeout runs cmd and returns the fallback message if it takes longer than d.
+func withTimeout(cmd tea.Cmd, d time.Duration, fallback tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		result := make(chan tea.Msg, 1)
+		go func() {
+			result <- cmd()
+		}()
+
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case msg := <-result:
+			return msg
+		case <-timer.C:
+			if fallback == nil {
+				return nil
+			}
+			return fallback()
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+type pingMsg struct{ n int }
+
+func recordingUpdate(received *[]tea.Msg) runetui.UpdateFunc {
+	return func(msg tea.Msg) tea.Cmd {
+		*received = append(*received, msg)
+		return nil
+	}
+}
+
+func isPing(msg tea.Msg) bool {
+	_, ok := msg.(pingMsg)
+	return ok
+}
+
+func TestChain_AppliesMiddlewareOutermostFirst(t *testing.T) {
+	var order []string
+	tag := func(name string) Middleware {
+		return func(next runetui.UpdateFunc) runetui.UpdateFunc {
+			return func(msg tea.Msg) tea.Cmd {
+				order = append(order, name)
+				return next(msg)
+			}
+		}
+	}
+	update := Chain(tag("a"), tag("b"), tag("c"))(func(msg tea.Msg) tea.Cmd {
+		order = append(order, "update")
+		return nil
+	})
+
+	update(pingMsg{})
+
+	got := strings.Join(order, ",")
+	if got != "a,b,c,update" {
+		t.Errorf("expected a,b,c,update, got %s", got)
+	}
+}
+
+func TestChain_WithNoMiddleware_ReturnsNextUnchanged(t *testing.T) {
+	var received []tea.Msg
+	update := Chain()(recordingUpdate(&received))
+
+	update(pingMsg{n: 1})
+
+	if len(received) != 1 || received[0] != (pingMsg{n: 1}) {
+		t.Errorf("expected message to pass through, got %v", received)
+	}
+}
+
+func TestFilter_DropsRejectedMessages(t *testing.T) {
+	var received []tea.Msg
+	update := Filter(isPing)(recordingUpdate(&received))
+
+	update(pingMsg{n: 1})
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if len(received) != 1 || received[0] != (pingMsg{n: 1}) {
+		t.Errorf("expected only pingMsg, got %v", received)
+	}
+}
+
+func TestDebounce_OnlyLatestMatchingMessageIsDelivered(t *testing.T) {
+	var received []tea.Msg
+	update := Debounce(time.Millisecond, isPing)(recordingUpdate(&received))
+
+	first := update(pingMsg{n: 1})
+	second := update(pingMsg{n: 2})
+
+	if len(received) != 0 {
+		t.Fatalf("expected messages to be delayed, got %v", received)
+	}
+	update(first())
+	update(second())
+
+	if len(received) != 1 || received[0] != (pingMsg{n: 2}) {
+		t.Errorf("expected only pingMsg{2}, got %v", received)
+	}
+}
+
+func TestDebounce_NonMatchingMessagePassesThrough(t *testing.T) {
+	var received []tea.Msg
+	update := Debounce(time.Hour, isPing)(recordingUpdate(&received))
+
+	cmd := update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if cmd != nil {
+		t.Error("expected no delay command for non-matching message")
+	}
+	if len(received) != 1 {
+		t.Errorf("expected message to pass through, got %v", received)
+	}
+}
+
+func TestDebounce_IgnoresMessagesFromOtherDebouncers(t *testing.T) {
+	var receivedA, receivedB []tea.Msg
+	updateA := Debounce(time.Millisecond, isPing)(recordingUpdate(&receivedA))
+	updateB := Debounce(time.Millisecond, isPing)(recordingUpdate(&receivedB))
+
+	pending := updateA(pingMsg{n: 1})()
+	updateB(pending)
+
+	if len(receivedB) != 1 || receivedB[0] != pending {
+		t.Errorf("expected foreign debounced message to pass through untouched, got %v", receivedB)
+	}
+	if len(receivedA) != 0 {
+		t.Errorf("expected debouncer A not to deliver, got %v", receivedA)
+	}
+}
+
+func TestThrottle_DropsMessagesWithinInterval(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+	defer func() { now = time.Now }()
+
+	var received []tea.Msg
+	update := Throttle(time.Second, isPing)(recordingUpdate(&received))
+
+	update(pingMsg{n: 1})
+	clock = clock.Add(500 * time.Millisecond)
+	update(pingMsg{n: 2})
+	clock = clock.Add(600 * time.Millisecond)
+	update(pingMsg{n: 3})
+
+	if len(received) != 2 || received[0] != (pingMsg{n: 1}) || received[1] != (pingMsg{n: 3}) {
+		t.Errorf("expected pingMsg{1} and pingMsg{3}, got %v", received)
+	}
+}
+
+func TestThrottle_NonMatchingMessagesAreNeverDropped(t *testing.T) {
+	var received []tea.Msg
+	update := Throttle(time.Hour, isPing)(recordingUpdate(&received))
+
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if len(received) != 2 {
+		t.Errorf("expected 2 messages, got %d", len(received))
+	}
+}
+
+func TestLog_WritesMessageTypeAtDebugLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	var received []tea.Msg
+	update := Log(logger)(recordingUpdate(&received))
+
+	update(pingMsg{n: 7})
+
+	if !strings.Contains(buf.String(), "msg=update") || !strings.Contains(buf.String(), "middleware.pingMsg") {
+		t.Errorf("expected log line with message type, got %q", buf.String())
+	}
+	if len(received) != 1 {
+		t.Errorf("expected message to be forwarded, got %v", received)
+	}
+}
+
+func TestRecover_PanicCallsFallbackAndReturnsNil(t *testing.T) {
+	var recovered interface{}
+	update := Recover(func(v interface{}) { recovered = v })(func(msg tea.Msg) tea.Cmd {
+		panic("boom")
+	})
+
+	cmd := update(pingMsg{})
+
+	if recovered != "boom" {
+		t.Errorf("expected fallback to receive 'boom', got %v", recovered)
+	}
+	if cmd != nil {
+		t.Error("expected nil cmd after panic")
+	}
+}
+
+func TestRecover_WithoutPanic_ReturnsCommand(t *testing.T) {
+	called := false
+	update := Recover(func(v interface{}) { called = true })(func(msg tea.Msg) tea.Cmd {
+		return func() tea.Msg { return pingMsg{n: 1} }
+	})
+
+	cmd := update(pingMsg{})
+
+	if called {
+		t.Error("expected fallback not to be called")
+	}
+	if cmd == nil || cmd() != (pingMsg{n: 1}) {
+		t.Error("expected original command to be returned")
+	}
+}
+
+func TestTimeout_FastCommand_ReturnsItsMessage(t *testing.T) {
+	update := Timeout(time.Second, nil)(func(msg tea.Msg) tea.Cmd {
+		return func() tea.Msg { return pingMsg{n: 1} }
+	})
+
+	got := update(pingMsg{})()
+
+	if got != (pingMsg{n: 1}) {
+		t.Errorf("expected pingMsg{1}, got %v", got)
+	}
+}
+
+func TestTimeout_SlowCommand_ReturnsFallbackMessage(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	fallback := func() tea.Msg { return pingMsg{n: -1} }
+	update := Timeout(time.Millisecond, fallback)(func(msg tea.Msg) tea.Cmd {
+		return func() tea.Msg {
+			<-release
+			return pingMsg{n: 1}
+		}
+	})
+
+	got := update(pingMsg{})()
+
+	if got != (pingMsg{n: -1}) {
+		t.Errorf("expected fallback message, got %v", got)
+	}
+}
+
+func TestTimeout_SlowCommandWithoutFallback_ReturnsNil(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	update := Timeout(time.Millisecond, nil)(func(msg tea.Msg) tea.Cmd {
+		return func() tea.Msg {
+			<-release
+			return pingMsg{n: 1}
+		}
+	})
+
+	if got := update(pingMsg{})(); got != nil {
+		t.Errorf("expected nil message, got %v", got)
+	}
+}
+
+func TestTimeout_NilCommand_ReturnsNil(t *testing.T) {
+	update := Timeout(time.Second, nil)(func(msg tea.Msg) tea.Cmd { return nil })
+
+	if cmd := update(pingMsg{}); cmd != nil {
+		t.Error("expected nil cmd")
+	}
+}
@@ -0,0 +1,52 @@
+package runetui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// MouseHandler handles a mouse event aimed at the component with the given
+// key. Use Handle to route tea.MouseMsg values from an UpdateFunc.
+//
+// Example:
+//
+//	onMouse := runetui.MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+//	    if key == "save" && msg.Action == tea.MouseActionPress {
+//	        return save
+//	    }
+//	    return nil
+//	})
+//
+//	update := func(msg tea.Msg) tea.Cmd {
+//	    return onMouse.Handle(app, msg)
+//	}
+type MouseHandler func(key string, msg tea.MouseMsg) tea.Cmd
+
+// Handle calls h with the key of the innermost keyed component under the
+// mouse pointer in app's most recently rendered frame. It returns nil without
+// calling h when msg is not a tea.MouseMsg or no keyed component is under the
+// pointer. Lines printed to the static zone are skipped, so the pointer is
+// matched against the dynamic content below them.
+func (h MouseHandler) Handle(app *App, msg tea.Msg) tea.Cmd {
+	mouse, ok := msg.(tea.MouseMsg)
+	if !ok {
+		return nil
+	}
+	tree, top := app.layoutTreeAt()
+	key := keyOf(app.layoutEngine.HitTest(tree, mouse.X, mouse.Y-top))
+	if key == "" {
+		return nil
+	}
+	return h(key, mouse)
+}
+
+// keyOf returns the key of node or of its nearest keyed ancestor, or "" when
+// none of them has a key.
+func keyOf(node *LayoutTree) string {
+	for ; node != nil; node = node.Parent() {
+		if node.Component == nil {
+			continue
+		}
+		if key := node.Component.Key(); key != "" {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package runetui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// mouseTestApp returns an app that has rendered a toolbar below the given
+// static lines.
+func mouseTestApp(static ...string) *App {
+	app := New(func() Component {
+		return Box(BoxProps{Direction: Row, Key: "toolbar"},
+			Box(BoxProps{Key: "save", Padding: SpacingCSSLike(0, 0, 0, 1)}, Text("Save")),
+			Box(BoxProps{Key: "quit"}, Text("Quit")),
+		)
+	})
+	app.staticManager.Append(static...)
+	app.createModel().View()
+	return app
+}
+
+func TestMouseHandler_Handle_CallsWithKeyUnderPointer(t *testing.T) {
+	var got string
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		got = key
+		return nil
+	})
+
+	handler.Handle(mouseTestApp(), tea.MouseMsg{X: 6, Y: 0, Action: tea.MouseActionPress})
+
+	if got != "quit" {
+		t.Errorf("expected key %q, got %q", "quit", got)
+	}
+}
+
+func TestMouseHandler_Handle_PaddingBelongsToBoxKey(t *testing.T) {
+	var got string
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		got = key
+		return nil
+	})
+
+	handler.Handle(mouseTestApp(), tea.MouseMsg{X: 0, Y: 0})
+
+	if got != "save" {
+		t.Errorf("expected key %q, got %q", "save", got)
+	}
+}
+
+func TestMouseHandler_Handle_OutsideTree_DoesNotCall(t *testing.T) {
+	called := false
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		called = true
+		return nil
+	})
+
+	handler.Handle(mouseTestApp(), tea.MouseMsg{X: 40, Y: 5})
+
+	if called {
+		t.Error("expected no call outside the tree")
+	}
+}
+
+func TestMouseHandler_Handle_NonMouseMsg_DoesNotCall(t *testing.T) {
+	called := false
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		called = true
+		return nil
+	})
+
+	handler.Handle(mouseTestApp(), tea.KeyMsg{Type: tea.KeyEnter})
+
+	if called {
+		t.Error("expected no call for a key message")
+	}
+}
+
+func TestMouseHandler_Handle_TextInsideKeyedBox_UsesBoxKey(t *testing.T) {
+	var got string
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		got = key
+		return nil
+	})
+
+	handler.Handle(mouseTestApp(), tea.MouseMsg{X: 2, Y: 0})
+
+	if got != "save" {
+		t.Errorf("expected key %q, got %q", "save", got)
+	}
+}
+
+func TestMouseHandler_Handle_SkipsStaticZone(t *testing.T) {
+	var got string
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		got = key
+		return nil
+	})
+	app := mouseTestApp("log one", "log two")
+
+	handler.Handle(app, tea.MouseMsg{X: 6, Y: 0})
+	if got != "" {
+		t.Errorf("expected no key over the static zone, got %q", got)
+	}
+
+	handler.Handle(app, tea.MouseMsg{X: 6, Y: 2})
+	if got != "quit" {
+		t.Errorf("expected key %q below the static zone, got %q", "quit", got)
+	}
+}
+
+func TestMouseHandler_Handle_BeforeFirstRender_DoesNotCall(t *testing.T) {
+	called := false
+	handler := MouseHandler(func(key string, msg tea.MouseMsg) tea.Cmd {
+		called = true
+		return nil
+	})
+
+	handler.Handle(New(func() Component { return Text("Hello") }), tea.MouseMsg{X: 0, Y: 0})
+
+	if called {
+		t.Error("expected no call before the first render")
+	}
+}
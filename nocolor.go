@@ -0,0 +1,26 @@
+package runetui
+
+import "os"
+
+// noColorRender disables colors and text attributes for the render in
+// progress. It is set by the app around each View, like the static manager.
+var noColorRender bool
+
+// setNoColor enables or disables plain rendering for subsequent renders.
+func setNoColor(noColor bool) {
+	noColorRender = noColor
+}
+
+// WithNoColor renders every component without colors or text attributes,
+// regardless of the props set on them. New enables this automatically when
+// the NO_COLOR environment variable is set to a non-empty value.
+func WithNoColor() AppOption {
+	return func(a *App) {
+		a.noColor = true
+	}
+}
+
+// noColorFromEnv reports whether the NO_COLOR convention asks for plain output.
+func noColorFromEnv() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
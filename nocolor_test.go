@@ -0,0 +1,63 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestText_NoColor_SkipsAttributes(t *testing.T) {
+	txt := Text("Hi", TextProps{Color: "#FF0000", Bold: true, NoColor: true})
+
+	output := txt.Render(Layout{Width: 4, Height: 1})
+
+	if output != "Hi  " {
+		t.Errorf("expected plain padded output, got %q", output)
+	}
+}
+
+func TestWithNoColor_AppRendersPlainOutput(t *testing.T) {
+	app := New(func() Component {
+		return Box(BoxProps{Border: BorderSingle, BorderColor: "#00FF00", Background: "#000000"},
+			Text("Hi", TextProps{Color: "#FF0000", Italic: true}))
+	}, WithNoColor())
+
+	output := app.createModel().View()
+
+	if strings.Contains(output, "\x1b[") {
+		t.Errorf("expected no ANSI codes, got %q", output)
+	}
+	if !strings.Contains(output, "Hi") {
+		t.Errorf("expected content, got %q", output)
+	}
+}
+
+func TestWithNoColor_ResetAfterView(t *testing.T) {
+	app := New(func() Component { return Text("Hi") }, WithNoColor())
+	app.createModel().View()
+
+	output := Text("Hi", TextProps{Bold: true}).Render(Layout{Width: 2, Height: 1})
+
+	if !strings.Contains(output, "\x1b[") {
+		t.Errorf("expected styled output outside the app render, got %q", output)
+	}
+}
+
+func TestNew_NoColorEnv_EnablesNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	app := New(func() Component { return Text("Hi") })
+
+	if !app.noColor {
+		t.Error("expected NO_COLOR to enable plain output")
+	}
+}
+
+func TestNew_EmptyNoColorEnv_KeepsColors(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	app := New(func() Component { return Text("Hi") })
+
+	if app.noColor {
+		t.Error("expected empty NO_COLOR to keep colors")
+	}
+}
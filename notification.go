@@ -0,0 +1,246 @@
+package runetui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultNotificationDuration is how long a notification stays visible when
+// NotificationProps.Duration is zero.
+const DefaultNotificationDuration = 3 * time.Second
+
+// NotifLevel defines the severity of a notification.
+type NotifLevel int
+
+const (
+	// NotifInfo is a neutral informational message (default).
+	NotifInfo NotifLevel = iota
+	// NotifSuccess reports a completed action.
+	NotifSuccess
+	// NotifWarning reports a recoverable problem.
+	NotifWarning
+	// NotifError reports a failure.
+	NotifError
+)
+
+var notifLevelNames = []string{"info", "success", "warning", "error"}
+
+// String returns the lowercase name of the level, e.g. "warning".
+func (l NotifLevel) String() string {
+	return enumName(notifLevelNames, int(l), "NotifLevel")
+}
+
+// notifLevelStyles holds the default color and icon for each level.
+var notifLevelStyles = []struct {
+	color string
+	icon  rune
+}{
+	{"#5DADE2", 'ℹ'},
+	{"#58D68D", '✓'},
+	{"#F4D03F", '⚠'},
+	{"#EC7063", '✗'},
+}
+
+// NotificationProps defines properties for the Notification component.
+// Color and Icon default to the level's color and icon, and Duration defaults
+// to DefaultNotificationDuration.
+type NotificationProps struct {
+	Level      NotifLevel
+	Duration   time.Duration
+	Color      string
+	Background string
+	Icon       rune
+	Key        string
+}
+
+func (NotificationProps) isProps() {}
+
+// NotificationExpiredMsg is dispatched when the notification with Key expires.
+type NotificationExpiredMsg struct {
+	Key string
+
+	// generation identifies the NotificationManager.Show call that scheduled
+	// the message; it is zero for messages from NotificationExpiry.
+	generation int
+}
+
+// Notification creates a one-line transient message such as "✓ Saved!".
+// Pair it with NotificationExpiry, or use a NotificationManager, to remove
+// it after its Duration.
+func Notification(msg string, props NotificationProps) Component {
+	props = notificationDefaults(props)
+	return Text(fmt.Sprintf(" %c %s ", props.Icon, msg), TextProps{
+		Color:      props.Color,
+		Background: props.Background,
+		Bold:       true,
+		Key:        props.Key,
+	})
+}
+
+// NotificationExpiry returns a command that dispatches NotificationExpiredMsg
+// for props.Key once the notification's Duration has elapsed.
+func NotificationExpiry(props NotificationProps) tea.Cmd {
+	props = notificationDefaults(props)
+	key := props.Key
+	return tea.Tick(props.Duration, func(time.Time) tea.Msg {
+		return NotificationExpiredMsg{Key: key}
+	})
+}
+
+// notificationDefaults fills in the level color, level icon and duration.
+func notificationDefaults(props NotificationProps) NotificationProps {
+	level := notifLevelStyles[NotifInfo]
+	if props.Level >= 0 && int(props.Level) < len(notifLevelStyles) {
+		level = notifLevelStyles[props.Level]
+	}
+	if props.Color == "" {
+		props.Color = level.color
+	}
+	if props.Icon == 0 {
+		props.Icon = level.icon
+	}
+	if props.Duration <= 0 {
+		props.Duration = DefaultNotificationDuration
+	}
+	return props
+}
+
+// activeNotification is a notification tracked by a NotificationManager.
+type activeNotification struct {
+	msg        string
+	props      NotificationProps
+	expires    time.Time
+	generation int
+}
+
+// NotificationManager tracks active notifications and removes them when they
+// expire. It is safe for concurrent use.
+//
+// Example:
+//
+//	notifications := runetui.NewNotificationManager()
+//
+//	update := func(msg tea.Msg) tea.Cmd {
+//	    if notifications.Update(msg) {
+//	        return nil
+//	    }
+//	    // ... on save:
+//	    return notifications.Show("Saved!", runetui.NotificationProps{Level: runetui.NotifSuccess})
+//	}
+//
+//	root := func() runetui.Component {
+//	    return runetui.VStack(content(), notifications.View())
+//	}
+type NotificationManager struct {
+	mu             sync.Mutex
+	active         []activeNotification
+	nextID         int
+	lastGeneration int
+}
+
+// NewNotificationManager creates an empty NotificationManager.
+func NewNotificationManager() *NotificationManager {
+	return &NotificationManager{}
+}
+
+// Show adds a notification and returns the command that expires it. A key is
+// generated when props.Key is empty; showing a key again replaces it, and the
+// replacement stays for its own Duration.
+func (m *NotificationManager) Show(msg string, props NotificationProps) tea.Cmd {
+	props = notificationDefaults(props)
+
+	m.mu.Lock()
+	if props.Key == "" {
+		m.nextID++
+		props.Key = fmt.Sprintf("notification-%d", m.nextID)
+	}
+	m.remove(props.Key)
+	m.lastGeneration++
+	generation := m.lastGeneration
+	m.active = append(m.active, activeNotification{
+		msg:        msg,
+		props:      props,
+		expires:    time.Now().Add(props.Duration),
+		generation: generation,
+	})
+	m.mu.Unlock()
+
+	key := props.Key
+	return tea.Tick(props.Duration, func(time.Time) tea.Msg {
+		return NotificationExpiredMsg{Key: key, generation: generation}
+	})
+}
+
+// Update removes the notification named by a NotificationExpiredMsg and
+// reports whether msg was such a message. Messages scheduled for a
+// notification that has since been replaced are handled without removing the
+// replacement.
+func (m *NotificationManager) Update(msg tea.Msg) bool {
+	expired, ok := msg.(NotificationExpiredMsg)
+	if !ok {
+		return false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, n := range m.active {
+		if n.props.Key == expired.Key && (expired.generation == 0 || expired.generation == n.generation) {
+			m.remove(expired.Key)
+			break
+		}
+	}
+	return true
+}
+
+// Dismiss removes the notification with key before it expires.
+func (m *NotificationManager) Dismiss(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remove(key)
+}
+
+// remove deletes the notification with key. The caller must hold m.mu.
+func (m *NotificationManager) remove(key string) {
+	for i, n := range m.active {
+		if n.props.Key == key {
+			m.active = append(m.active[:i], m.active[i+1:]...)
+			return
+		}
+	}
+}
+
+// Active returns the keys of the active notifications, oldest first.
+func (m *NotificationManager) Active() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, len(m.active))
+	for i, n := range m.active {
+		keys[i] = n.props.Key
+	}
+	return keys
+}
+
+// Expiry returns when the notification with key expires, and whether it is active.
+func (m *NotificationManager) Expiry(key string) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, n := range m.active {
+		if n.props.Key == key {
+			return n.expires, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// View renders the active notifications stacked vertically, oldest first.
+func (m *NotificationManager) View() Component {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rows := make([]Component, len(m.active))
+	for i, n := range m.active {
+		rows[i] = Notification(n.msg, n.props)
+	}
+	return VStack(rows...)
+}
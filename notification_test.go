@@ -0,0 +1,144 @@
+package runetui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNotifLevel_String(t *testing.T) {
+	tests := map[NotifLevel]string{
+		NotifInfo:     "info",
+		NotifSuccess:  "success",
+		NotifWarning:  "warning",
+		NotifError:    "error",
+		NotifLevel(8): "NotifLevel(8)",
+	}
+	for level, want := range tests {
+		if got := level.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(level), got, want)
+		}
+	}
+}
+
+func TestNotification_Render_ShowsLevelIconAndMessage(t *testing.T) {
+	n := Notification("Saved!", NotificationProps{Level: NotifSuccess})
+
+	output := n.Render(Layout{Width: 10, Height: 1})
+
+	AssertHasANSICodes(t, output)
+	if StripANSI(output) != " ✓ Saved! " {
+		t.Errorf("expected %q, got %q", " ✓ Saved! ", StripANSI(output))
+	}
+}
+
+func TestNotification_CustomIconAndColor_OverrideLevel(t *testing.T) {
+	n := Notification("Oops", NotificationProps{Level: NotifError, Icon: '!', Color: "#FFFFFF", Key: "err"}).(*text)
+
+	if n.props.Color != "#FFFFFF" || n.content != " ! Oops " || n.Key() != "err" {
+		t.Errorf("unexpected notification %q with props %+v", n.content, n.props)
+	}
+}
+
+func TestNotificationDefaults_FillsLevelStyleAndDuration(t *testing.T) {
+	props := notificationDefaults(NotificationProps{Level: NotifWarning})
+	unknown := notificationDefaults(NotificationProps{Level: NotifLevel(9)})
+
+	if props.Icon != '⚠' || props.Color != "#F4D03F" || props.Duration != DefaultNotificationDuration {
+		t.Errorf("unexpected defaults %+v", props)
+	}
+	if unknown.Icon != 'ℹ' {
+		t.Errorf("expected unknown level to fall back to info, got %+v", unknown)
+	}
+}
+
+func TestNotificationExpiry_DispatchesExpiredMsg(t *testing.T) {
+	cmd := NotificationExpiry(NotificationProps{Key: "saved", Duration: time.Millisecond})
+
+	if msg := cmd(); msg != (NotificationExpiredMsg{Key: "saved"}) {
+		t.Errorf("expected NotificationExpiredMsg{Key: \"saved\"}, got %#v", msg)
+	}
+}
+
+func TestNotificationManager_ShowAndExpire(t *testing.T) {
+	m := NewNotificationManager()
+
+	cmd := m.Show("Saved!", NotificationProps{Duration: time.Millisecond})
+	m.Show("Synced", NotificationProps{Key: "sync"})
+
+	if keys := m.Active(); len(keys) != 2 || keys[0] != "notification-1" || keys[1] != "sync" {
+		t.Fatalf("unexpected active notifications %v", keys)
+	}
+	if !m.Update(cmd()) {
+		t.Error("expected expired message to be handled")
+	}
+	if keys := m.Active(); len(keys) != 1 || keys[0] != "sync" {
+		t.Errorf("expected only 'sync' to remain, got %v", keys)
+	}
+}
+
+func TestNotificationManager_ShowSameKey_Replaces(t *testing.T) {
+	m := NewNotificationManager()
+
+	m.Show("one", NotificationProps{Key: "status"})
+	m.Show("two", NotificationProps{Key: "status"})
+
+	if keys := m.Active(); len(keys) != 1 {
+		t.Errorf("expected 1 notification, got %v", keys)
+	}
+	AssertContainsText(t, m.View().Render(Layout{Width: 10, Height: 1}), "two")
+}
+
+func TestNotificationManager_ShowSameKeyTwice_FirstExpiryKeepsReplacement(t *testing.T) {
+	m := NewNotificationManager()
+
+	first := m.Show("one", NotificationProps{Key: "status", Duration: time.Millisecond})
+	second := m.Show("two", NotificationProps{Key: "status", Duration: time.Millisecond})
+
+	if !m.Update(first()) {
+		t.Error("expected the stale expired message to be handled")
+	}
+	if keys := m.Active(); len(keys) != 1 || keys[0] != "status" {
+		t.Fatalf("expected the replacement to stay after the first expiry, got %v", keys)
+	}
+	m.Update(second())
+	if keys := m.Active(); len(keys) != 0 {
+		t.Errorf("expected the replacement to expire on its own message, got %v", keys)
+	}
+}
+
+func TestNotificationManager_Update_ExpiryFromNotificationExpiry_Removes(t *testing.T) {
+	m := NewNotificationManager()
+	m.Show("hi", NotificationProps{Key: "hi", Duration: time.Minute})
+
+	m.Update(NotificationExpiredMsg{Key: "hi"})
+
+	if keys := m.Active(); len(keys) != 0 {
+		t.Errorf("expected the notification to be removed, got %v", keys)
+	}
+}
+
+func TestNotificationManager_ExpiryAndDismiss(t *testing.T) {
+	m := NewNotificationManager()
+	before := time.Now()
+	m.Show("hi", NotificationProps{Key: "hi", Duration: time.Minute})
+
+	expires, ok := m.Expiry("hi")
+	if !ok || expires.Before(before.Add(time.Minute)) {
+		t.Errorf("unexpected expiry %v, %v", expires, ok)
+	}
+
+	m.Dismiss("hi")
+	if _, ok := m.Expiry("hi"); ok {
+		t.Error("expected notification to be dismissed")
+	}
+}
+
+func TestNotificationManager_Update_IgnoresOtherMessages(t *testing.T) {
+	m := NewNotificationManager()
+
+	if m.Update(tea.KeyMsg{Type: tea.KeyEnter}) {
+		t.Error("expected other messages to be ignored")
+	}
+}
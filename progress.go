@@ -0,0 +1,112 @@
+package runetui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// progressPercentWidth is the width of the " 100%" suffix.
+const progressPercentWidth = 5
+
+// ProgressBarProps defines properties for the ProgressBar component.
+// Value is the completed fraction from 0.0 to 1.0. Width is the total width
+// including the brackets and percentage; the layout width is used when it is
+// zero. FilledChar defaults to '█' and EmptyChar to '░'.
+type ProgressBarProps struct {
+	Value       float64
+	Width       int
+	FilledChar  rune
+	EmptyChar   rune
+	FilledColor string
+	EmptyColor  string
+	ShowPercent bool
+	Key         string
+}
+
+func (ProgressBarProps) isProps() {}
+
+// progressBar is the private implementation of the ProgressBar component.
+type progressBar struct {
+	props ProgressBarProps
+}
+
+// ProgressBar creates a one-line bar such as "[████████░░░░] 67%". Stack
+// several in a VStack to show parallel tasks.
+//
+// Example:
+//
+//	VStack(
+//	    ProgressBar(ProgressBarProps{Value: 0.67, Width: 20, ShowPercent: true}),
+//	    ProgressBar(ProgressBarProps{Value: 0.25, Width: 20, ShowPercent: true}),
+//	)
+func ProgressBar(props ProgressBarProps) Component {
+	if props.FilledChar == 0 {
+		props.FilledChar = '█'
+	}
+	if props.EmptyChar == 0 {
+		props.EmptyChar = '░'
+	}
+	if math.IsNaN(props.Value) {
+		props.Value = 0
+	}
+	props.Value = math.Max(0, math.Min(props.Value, 1))
+	return &progressBar{props: props}
+}
+
+// Render generates the bar, padded to the layout width.
+func (p *progressBar) Render(layout Layout) string {
+	width := p.props.Width
+	if width <= 0 {
+		width = layout.Width
+	}
+
+	inner := width - 2
+	if p.props.ShowPercent {
+		inner -= progressPercentWidth
+	}
+	inner = max(0, inner)
+	filled := int(math.Round(p.props.Value * float64(inner)))
+
+	var b strings.Builder
+	b.WriteString("[")
+	b.WriteString(Text(strings.Repeat(string(p.props.FilledChar), filled),
+		TextProps{Color: p.props.FilledColor}).Render(Layout{}))
+	b.WriteString(Text(strings.Repeat(string(p.props.EmptyChar), inner-filled),
+		TextProps{Color: p.props.EmptyColor}).Render(Layout{}))
+	b.WriteString("]")
+	if p.props.ShowPercent {
+		fmt.Fprintf(&b, " %3d%%", int(math.Round(p.props.Value*100)))
+	}
+
+	line := b.String()
+	if pad := layout.Width - lipgloss.Width(line); pad > 0 {
+		line += strings.Repeat(" ", pad)
+	}
+	return line
+}
+
+// Children returns an empty slice since progress bars have no children.
+func (p *progressBar) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (p *progressBar) Key() string {
+	return p.props.Key
+}
+
+func (p *progressBar) label() string {
+	return "progress"
+}
+
+// Measure returns Width and a single line. With no Width the bar takes the
+// available width.
+func (p *progressBar) Measure(availableWidth, availableHeight int) Size {
+	if p.props.Width > 0 {
+		return Size{Width: p.props.Width, Height: 1}
+	}
+	return Size{Width: availableWidth, Height: 1}
+}
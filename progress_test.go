@@ -0,0 +1,87 @@
+package runetui
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestProgressBar_Render_FillsProportionally(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{0, "[░░░░░░░░░░]"},
+		{0.5, "[█████░░░░░]"},
+		{1, "[██████████]"},
+		{1.5, "[██████████]"},
+		{-1, "[░░░░░░░░░░]"},
+		{math.NaN(), "[░░░░░░░░░░]"},
+	}
+
+	for _, tt := range tests {
+		output := StripANSI(ProgressBar(ProgressBarProps{Value: tt.value, Width: 12}).Render(Layout{}))
+		if output != tt.expected {
+			t.Errorf("value %v: expected %q, got %q", tt.value, tt.expected, output)
+		}
+	}
+}
+
+func TestProgressBar_Render_ShowPercent(t *testing.T) {
+	output := StripANSI(ProgressBar(ProgressBarProps{Value: 0.67, Width: 17, ShowPercent: true}).Render(Layout{}))
+
+	if output != "[███████░░░]  67%" {
+		t.Errorf("unexpected bar %q", output)
+	}
+}
+
+func TestProgressBar_Render_CustomCharsAndColors(t *testing.T) {
+	output := ProgressBar(ProgressBarProps{
+		Value:       0.5,
+		Width:       6,
+		FilledChar:  '=',
+		EmptyChar:   '-',
+		FilledColor: "#00FF00",
+		EmptyColor:  "#444444",
+	}).Render(Layout{})
+
+	if StripANSI(output) != "[==--]" {
+		t.Errorf("unexpected bar %q", StripANSI(output))
+	}
+	AssertHasANSICodes(t, output)
+}
+
+func TestProgressBar_Render_FallsBackToLayoutWidthAndPads(t *testing.T) {
+	auto := StripANSI(ProgressBar(ProgressBarProps{Value: 1}).Render(Layout{Width: 6}))
+	if auto != "[████]" {
+		t.Errorf("expected layout width bar, got %q", auto)
+	}
+
+	padded := StripANSI(ProgressBar(ProgressBarProps{Width: 4}).Render(Layout{Width: 8}))
+	if padded != "[░░]    " {
+		t.Errorf("expected bar padded to layout width, got %q", padded)
+	}
+}
+
+func TestProgressBar_Measure(t *testing.T) {
+	if size := ProgressBar(ProgressBarProps{Width: 30}).Measure(80, 24); size != (Size{Width: 30, Height: 1}) {
+		t.Errorf("expected 30x1, got %+v", size)
+	}
+	if size := ProgressBar(ProgressBarProps{}).Measure(80, 24); size != (Size{Width: 80, Height: 1}) {
+		t.Errorf("expected available width, got %+v", size)
+	}
+}
+
+func TestProgressBar_InVStack_StacksBars(t *testing.T) {
+	root := VStack(
+		ProgressBar(ProgressBarProps{Value: 1, Width: 4, Key: "a"}),
+		ProgressBar(ProgressBarProps{Value: 0, Width: 4, Key: "b"}),
+	)
+	tree := NewLayoutEngine(20, 5).CalculateLayout(root)
+
+	lines := strings.Split(StripANSI(root.Render(tree.Layout)), "\n")
+
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "[██]") || !strings.HasPrefix(lines[1], "[░░]") {
+		t.Errorf("expected two stacked bars, got %q", lines)
+	}
+}
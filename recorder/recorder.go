@@ -0,0 +1,131 @@
+// Package recorder records RuneTUI sessions as a sequence of rendered frames
+// and replays them as an ANSI stream.
+//
+// Recording runs the app without a terminal: each event is sent to the app's
+// update function and the frame rendered afterwards is captured. Commands
+// returned by the update function are not run, so a recording depends only on
+// its events. Saved sessions can be attached to CI runs and played back with
+// cat.
+//
+// Example usage:
+//
+//	session := recorder.Record(app, []recorder.Event{
+//	    recorder.Resize(60, 10),
+//	    recorder.Type("alice"),
+//	    recorder.Key(tea.KeyEnter),
+//	})
+//	if err := session.Save("testdata/login.cast"); err != nil {
+//	    t.Fatal(err)
+//	}
+//	runetui.AssertContainsText(t, session.Frames()[3], "Welcome, alice")
+//
+//	// Later, from a shell: cat testdata/login.cast
+package recorder
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+// clearScreen moves the cursor home and clears the screen. It starts every
+// frame in a saved session.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// Event is a message sent to the app during a recording.
+type Event struct {
+	Msg tea.Msg
+}
+
+// Msg returns an event that sends msg unchanged.
+func Msg(msg tea.Msg) Event {
+	return Event{Msg: msg}
+}
+
+// Key returns an event for pressing a special key such as tea.KeyEnter.
+func Key(key tea.KeyType) Event {
+	return Event{Msg: tea.KeyMsg{Type: key}}
+}
+
+// Type returns an event that sends s as a single tea.KeyRunes message, the
+// way a paste or quick typing arrives from the terminal.
+func Type(s string) Event {
+	return Event{Msg: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}}
+}
+
+// Resize returns an event that resizes the terminal to width x height.
+func Resize(width, height int) Event {
+	return Event{Msg: tea.WindowSizeMsg{Width: width, Height: height}}
+}
+
+// Session is a recorded sequence of frames.
+type Session struct {
+	frames []string
+}
+
+// Record runs app without a terminal, sending each event in order, and
+// returns the captured frames: the initial frame followed by one frame per
+// event.
+func Record(app *runetui.App, events []Event) *Session {
+	model := app.Model()
+	session := &Session{frames: []string{model.View()}}
+	for _, event := range events {
+		model, _ = model.Update(event.Msg)
+		session.frames = append(session.frames, model.View())
+	}
+	return session
+}
+
+// Frames returns the rendered frames in order, with ANSI styling intact.
+func (s *Session) Frames() []string {
+	return append([]string(nil), s.frames...)
+}
+
+// Save writes the session to path as an ANSI stream in which every frame
+// clears the screen before drawing itself.
+func (s *Session) Save(path string) error {
+	var b strings.Builder
+	for _, frame := range s.frames {
+		b.WriteString(clearScreen)
+		b.WriteString(frame)
+		b.WriteString("\n")
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// Load reads a session written by Save.
+func Load(path string) (*Session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	session := &Session{}
+	for _, frame := range strings.Split(string(data), clearScreen)[1:] {
+		session.frames = append(session.frames, strings.TrimSuffix(frame, "\n"))
+	}
+	return session, nil
+}
+
+// Replay returns the ANSI stream of the session saved at path, suitable for
+// writing to a terminal. If the file cannot be read, reading from the
+// returned reader fails with that error.
+func Replay(path string) io.Reader {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return &errReader{err: err}
+	}
+	return bytes.NewReader(data)
+}
+
+// errReader is an io.Reader that always fails with err.
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
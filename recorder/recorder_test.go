@@ -0,0 +1,124 @@
+package recorder
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/runetui/runetui"
+)
+
+func newEchoApp() *runetui.App {
+	typed := ""
+	return runetui.New(func() runetui.Component {
+		return runetui.Text("> " + typed)
+	}, runetui.WithUpdate(func(msg tea.Msg) tea.Cmd {
+		if key, ok := msg.(tea.KeyMsg); ok {
+			switch key.Type {
+			case tea.KeyRunes:
+				typed += string(key.Runes)
+			case tea.KeyBackspace:
+				typed = typed[:len(typed)-1]
+			}
+		}
+		return nil
+	}))
+}
+
+func TestRecord_CapturesInitialFrameAndOnePerEvent(t *testing.T) {
+	session := Record(newEchoApp(), []Event{
+		Type("hi"),
+		Type("!"),
+		Key(tea.KeyBackspace),
+	})
+
+	expected := []string{"> ", "> hi", "> hi!", "> hi"}
+	frames := session.Frames()
+	if len(frames) != len(expected) {
+		t.Fatalf("expected %d frames, got %d: %q", len(expected), len(frames), frames)
+	}
+	for i, want := range expected {
+		if runetui.StripANSI(frames[i]) != want {
+			t.Errorf("frame %d: expected %q, got %q", i, want, frames[i])
+		}
+	}
+}
+
+func TestRecord_Resize_ChangesLayout(t *testing.T) {
+	app := runetui.New(func() runetui.Component {
+		return runetui.Text(strings.Repeat("x", 30), runetui.TextProps{Wrap: runetui.WrapWord})
+	})
+
+	frames := Record(app, []Event{Resize(10, 5)}).Frames()
+
+	expected := strings.TrimSuffix(strings.Repeat(strings.Repeat("x", 10)+"\n", 3), "\n")
+	if frames[1] != expected {
+		t.Errorf("expected text wrapped at 10 columns after resize, got %q", frames[1])
+	}
+}
+
+func TestRecord_Msg_SendsCustomMessage(t *testing.T) {
+	type greetMsg struct{ name string }
+	name := ""
+	app := runetui.New(func() runetui.Component {
+		return runetui.Text("hello " + name)
+	}, runetui.WithUpdate(func(msg tea.Msg) tea.Cmd {
+		if greet, ok := msg.(greetMsg); ok {
+			name = greet.name
+		}
+		return nil
+	}))
+
+	frames := Record(app, []Event{Msg(greetMsg{name: "bob"})}).Frames()
+
+	if frames[1] != "hello bob" {
+		t.Errorf("expected %q, got %q", "hello bob", frames[1])
+	}
+}
+
+func TestSession_SaveLoadAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "echo.cast")
+	session := Record(newEchoApp(), []Event{Type("ab")})
+
+	if err := session.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if strings.Join(loaded.Frames(), "|") != strings.Join(session.Frames(), "|") {
+		t.Errorf("expected loaded frames %q, got %q", session.Frames(), loaded.Frames())
+	}
+
+	stream, err := io.ReadAll(Replay(path))
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if strings.Count(string(stream), clearScreen) != 2 || !strings.HasSuffix(string(stream), "> ab\n") {
+		t.Errorf("unexpected replay stream %q", stream)
+	}
+}
+
+func TestReplay_MissingFile_FailsOnRead(t *testing.T) {
+	_, err := io.ReadAll(Replay(filepath.Join(t.TempDir(), "missing.cast")))
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestFrames_ReturnsCopy(t *testing.T) {
+	session := Record(newEchoApp(), nil)
+
+	session.Frames()[0] = "changed"
+
+	if session.Frames()[0] == "changed" {
+		t.Error("expected Frames to return a copy")
+	}
+}
@@ -0,0 +1,53 @@
+package runetui
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// WithRecovery catches panics raised while rendering the component tree.
+// Instead of crashing the app, the fallback component is rendered for that frame,
+// below the static zone. The first occurrence of each distinct panic is logged
+// with its stack trace: to the static zone when the app was created with
+// WithSlogHandler, and to slog.Default() otherwise.
+func WithRecovery(fallback func(err interface{}) Component) AppOption {
+	return func(a *App) {
+		a.recovery = fallback
+	}
+}
+
+// DefaultRecovery returns a fallback that renders the panic value in a red bordered box.
+func DefaultRecovery() func(err interface{}) Component {
+	return func(err interface{}) Component {
+		return Box(
+			BoxProps{
+				Direction:   Column,
+				Border:      BorderRounded,
+				BorderColor: "#FF0000",
+				Padding:     SpacingHorizontal(1),
+			},
+			Text("Render error", TextProps{Color: "#FF0000", Bold: true}),
+			Text(fmt.Sprintf("%v", err), TextProps{Color: "#FF0000"}),
+		)
+	}
+}
+
+// renderRecovery logs a render panic and renders the recovery fallback instead.
+func (a *App) renderRecovery(err interface{}) string {
+	a.logPanic(err)
+	tree := a.layoutEngine.CalculateLayout(a.recovery(err))
+	return a.frame(tree)
+}
+
+// logPanic logs err once per distinct panic value.
+func (a *App) logPanic(err interface{}) {
+	key := fmt.Sprint(err)
+	if a.loggedPanics[key] {
+		return
+	}
+	if a.loggedPanics == nil {
+		a.loggedPanics = make(map[string]bool)
+	}
+	a.loggedPanics[key] = true
+	a.renderLogger().Error("render panic", "panic", err, "stack", string(debug.Stack()))
+}
@@ -0,0 +1,203 @@
+package runetui
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type panickingComponent struct{}
+
+func (panickingComponent) Render(layout Layout) string { panic("nil widget") }
+func (panickingComponent) Children() []Component       { return []Component{} }
+func (panickingComponent) Key() string                 { return "" }
+func (panickingComponent) Measure(availableWidth, availableHeight int) Size {
+	return Size{Width: 1, Height: 1}
+}
+
+func TestWithRecovery_SetsFallback(t *testing.T) {
+	app := New(func() Component { return Text("Hello") }, WithRecovery(DefaultRecovery()))
+
+	if app.recovery == nil {
+		t.Fatal("expected recovery fallback to be set")
+	}
+}
+
+func TestModel_View_WithRecovery_RendersFallbackOnPanic(t *testing.T) {
+	var recovered interface{}
+	fallback := func(err interface{}) Component {
+		recovered = err
+		return Text("Something went wrong")
+	}
+	app := New(func() Component { return panickingComponent{} }, WithRecovery(fallback), WithSlogHandler())
+	m := app.createModel()
+
+	output := m.View()
+
+	if recovered != "nil widget" {
+		t.Errorf("expected fallback to receive panic value, got %v", recovered)
+	}
+	AssertContainsText(t, output, "Something went wrong")
+}
+
+func TestModel_View_WithRecovery_LogsPanicAndStack(t *testing.T) {
+	app := New(func() Component { return panickingComponent{} },
+		WithRecovery(func(err interface{}) Component { return Text("fallback") }),
+		WithSlogHandler(),
+	)
+	m := app.createModel()
+
+	m.View()
+
+	logged := app.staticManager.RenderStatic()
+	if !strings.Contains(logged, `msg="render panic"`) || !strings.Contains(logged, "panic=\"nil widget\"") {
+		t.Errorf("expected panic to be logged, got %q", logged)
+	}
+	if !strings.Contains(logged, "stack=") {
+		t.Errorf("expected stack trace to be logged, got %q", logged)
+	}
+}
+
+func TestModel_View_WithRecovery_KeepsRenderingAfterPanic(t *testing.T) {
+	broken := true
+	rootFunc := func() Component {
+		if broken {
+			return panickingComponent{}
+		}
+		return Text("Recovered")
+	}
+	app := New(rootFunc, WithRecovery(func(err interface{}) Component { return Text("fallback") }), WithSlogHandler())
+	m := app.createModel()
+
+	m.View()
+	broken = false
+	output := m.View()
+
+	AssertContainsText(t, output, "Recovered")
+}
+
+func TestModel_View_WithoutRecovery_Panics(t *testing.T) {
+	app := New(func() Component { return panickingComponent{} })
+	m := app.createModel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected View to panic without WithRecovery")
+		}
+	}()
+	m.View()
+}
+
+func TestDefaultRecovery_RendersRedBoxWithPanicValue(t *testing.T) {
+	app := New(func() Component { return panickingComponent{} }, WithRecovery(DefaultRecovery()), WithSlogHandler())
+	m := app.createModel()
+
+	output := m.View()
+
+	AssertContainsText(t, output, "Render error")
+	AssertContainsText(t, output, "nil widget")
+	AssertContainsText(t, output, "╭")
+	if !strings.Contains(output, "38;2;255;0;0") {
+		t.Errorf("expected red foreground, got %q", output)
+	}
+}
+
+func TestModel_View_WithRecoveryAndSlogHandler_LogsEachPanicOnce(t *testing.T) {
+	app := New(func() Component { return panickingComponent{} },
+		WithRecovery(func(err interface{}) Component { return Text("fallback") }),
+		WithSlogHandler(),
+	)
+	m := app.createModel()
+
+	m.View()
+	m.View()
+
+	if got := strings.Count(app.staticManager.RenderStatic(), "render panic"); got != 1 {
+		t.Errorf("expected the panic to be logged once, got %d", got)
+	}
+}
+
+func TestModel_View_WithRecoveryAndSlogHandler_LogsDistinctPanics(t *testing.T) {
+	value := "first"
+	app := New(func() Component { panic(value) },
+		WithRecovery(func(err interface{}) Component { return Text("fallback") }),
+		WithSlogHandler(),
+	)
+	m := app.createModel()
+
+	m.View()
+	value = "second"
+	m.View()
+
+	logged := app.staticManager.RenderStatic()
+	if !strings.Contains(logged, "panic=first") || !strings.Contains(logged, "panic=second") {
+		t.Errorf("expected both panics to be logged, got %q", logged)
+	}
+}
+
+func TestRun_WithRecoveryAndSlogHandler_DoesNotKeepRerendering(t *testing.T) {
+	var renders int32
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := New(func() Component {
+		atomic.AddInt32(&renders, 1)
+		return panickingComponent{}
+	}, WithRecovery(func(err interface{}) Component { return Text("fallback") }), WithSlogHandler())
+	app.teaOptions = headlessOptions()
+	ready := make(chan struct{})
+	app.OnReady(func() { close(ready) })
+
+	result := make(chan error, 1)
+	go func() { result <- app.RunContext(ctx) }()
+	<-ready
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-result
+
+	if got := atomic.LoadInt32(&renders); got > 3 {
+		t.Errorf("expected logging the panic not to trigger re-renders, got %d renders", got)
+	}
+}
+
+func TestModel_View_WithRecoveryWithoutSlogHandler_LogsToDefaultLogger(t *testing.T) {
+	var logged bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logged, nil)))
+	defer slog.SetDefault(previous)
+	app := New(func() Component { return panickingComponent{} },
+		WithRecovery(func(err interface{}) Component { return Text("fallback") }))
+	m := app.createModel()
+
+	m.View()
+	m.View()
+
+	if got := strings.Count(logged.String(), "render panic"); got != 1 {
+		t.Errorf("expected the panic to be logged once, got %d times in %q", got, logged.String())
+	}
+	if !strings.Contains(logged.String(), "stack=") {
+		t.Errorf("expected stack trace to be logged, got %q", logged.String())
+	}
+}
+
+func TestModel_View_WithRecovery_KeepsStaticZone(t *testing.T) {
+	app := New(func() Component { return panickingComponent{} },
+		WithRecovery(func(err interface{}) Component { return Text("fallback") }),
+		WithSlogHandler(),
+	)
+	app.staticManager.Append("earlier log line")
+	m := app.createModel()
+
+	output := m.View()
+
+	lines := strings.Split(output, "\n")
+	if lines[0] != "earlier log line" {
+		t.Errorf("expected the static zone first, got %q", output)
+	}
+	if !strings.HasSuffix(output, "fallback") {
+		t.Errorf("expected the fallback below the static zone, got %q", output)
+	}
+}
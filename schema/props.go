@@ -0,0 +1,239 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/runetui/runetui"
+)
+
+// boxProps is the JSON form of runetui.BoxProps.
+type boxProps struct {
+	Direction   string    `json:"direction"`
+	Width       dimension `json:"width"`
+	Height      dimension `json:"height"`
+	MinWidth    int       `json:"minWidth"`
+	MinHeight   int       `json:"minHeight"`
+	MaxWidth    int       `json:"maxWidth"`
+	MaxHeight   int       `json:"maxHeight"`
+	FlexGrow    float64   `json:"flexGrow"`
+	FlexShrink  float64   `json:"flexShrink"`
+	Align       string    `json:"align"`
+	Justify     string    `json:"justify"`
+	Padding     spacing   `json:"padding"`
+	Margin      spacing   `json:"margin"`
+	Gap         int       `json:"gap"`
+	GapX        int       `json:"gapX"`
+	GapY        int       `json:"gapY"`
+	Border      string    `json:"border"`
+	BorderColor string    `json:"borderColor"`
+	Background  string    `json:"background"`
+}
+
+// textProps is the JSON form of runetui.TextProps.
+type textProps struct {
+	Color         string `json:"color"`
+	Background    string `json:"background"`
+	Bold          bool   `json:"bold"`
+	Italic        bool   `json:"italic"`
+	Underline     bool   `json:"underline"`
+	Strikethrough bool   `json:"strikethrough"`
+	Wrap          string `json:"wrap"`
+	Align         string `json:"align"`
+	Prefix        string `json:"prefix"`
+	Suffix        string `json:"suffix"`
+}
+
+// spacerProps is the JSON form of a spacer. A size with an axis gives a fixed
+// spacer; otherwise the spacer grows by the given factor, or 1.
+type spacerProps struct {
+	Size int     `json:"size"`
+	Axis string  `json:"axis"`
+	Grow float64 `json:"grow"`
+}
+
+// decodeProps decodes raw into v, rejecting unknown fields.
+func decodeProps(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: props: %w", ErrInvalidSchema, err)
+	}
+	return nil
+}
+
+func buildBox(s ComponentSchema, children []runetui.Component) (runetui.Component, error) {
+	var p boxProps
+	if err := decodeProps(s.Props, &p); err != nil {
+		return nil, err
+	}
+
+	props := runetui.BoxProps{
+		Width:       p.Width.value,
+		Height:      p.Height.value,
+		MinWidth:    p.MinWidth,
+		MinHeight:   p.MinHeight,
+		MaxWidth:    p.MaxWidth,
+		MaxHeight:   p.MaxHeight,
+		FlexGrow:    p.FlexGrow,
+		FlexShrink:  p.FlexShrink,
+		Padding:     p.Padding.value,
+		Margin:      p.Margin.value,
+		Gap:         p.Gap,
+		GapX:        p.GapX,
+		GapY:        p.GapY,
+		BorderColor: p.BorderColor,
+		Background:  p.Background,
+		Key:         s.Key,
+	}
+
+	var err error
+	if p.Direction != "" {
+		if props.Direction, err = runetui.ParseDirection(p.Direction); err != nil {
+			return nil, err
+		}
+	}
+	if p.Align != "" {
+		if props.AlignItems, err = runetui.ParseAlign(p.Align); err != nil {
+			return nil, err
+		}
+	}
+	if p.Justify != "" {
+		if props.JustifyContent, err = runetui.ParseJustify(p.Justify); err != nil {
+			return nil, err
+		}
+	}
+	if p.Border != "" {
+		if props.Border, err = runetui.ParseBorderStyle(p.Border); err != nil {
+			return nil, err
+		}
+	}
+
+	return runetui.Box(props, children...), nil
+}
+
+func buildText(s ComponentSchema, children []runetui.Component) (runetui.Component, error) {
+	if len(children) > 0 {
+		return nil, fmt.Errorf("%w: Text cannot have children", ErrInvalidSchema)
+	}
+	var p textProps
+	if err := decodeProps(s.Props, &p); err != nil {
+		return nil, err
+	}
+
+	props := runetui.TextProps{
+		Color:         p.Color,
+		Background:    p.Background,
+		Bold:          p.Bold,
+		Italic:        p.Italic,
+		Underline:     p.Underline,
+		Strikethrough: p.Strikethrough,
+		Prefix:        p.Prefix,
+		Suffix:        p.Suffix,
+		Key:           s.Key,
+	}
+
+	var err error
+	if p.Wrap != "" {
+		if props.Wrap, err = runetui.ParseWrapMode(p.Wrap); err != nil {
+			return nil, err
+		}
+	}
+	if p.Align != "" {
+		if props.Align, err = runetui.ParseTextAlign(p.Align); err != nil {
+			return nil, err
+		}
+	}
+
+	return runetui.Text(s.Content, props), nil
+}
+
+func buildSpacer(s ComponentSchema, children []runetui.Component) (runetui.Component, error) {
+	if len(children) > 0 {
+		return nil, fmt.Errorf("%w: Spacer cannot have children", ErrInvalidSchema)
+	}
+	var p spacerProps
+	if err := decodeProps(s.Props, &p); err != nil {
+		return nil, err
+	}
+
+	switch p.Axis {
+	case "horizontal":
+		return runetui.HorizontalSpacer(p.Size), nil
+	case "vertical":
+		return runetui.VerticalSpacer(p.Size), nil
+	case "":
+		if p.Grow > 0 {
+			return runetui.FlexSpacerWithGrow(p.Grow), nil
+		}
+		return runetui.FlexSpacer(), nil
+	default:
+		return nil, fmt.Errorf("%w: spacer axis %q is not horizontal or vertical", ErrInvalidSchema, p.Axis)
+	}
+}
+
+// dimension decodes a runetui.Dimension from a number of cells, a percentage
+// string such as "50%", or "auto".
+type dimension struct {
+	value runetui.Dimension
+}
+
+func (d *dimension) UnmarshalJSON(data []byte) error {
+	var cells int
+	if err := json.Unmarshal(data, &cells); err == nil {
+		if cells < 0 {
+			return fmt.Errorf("%w: negative size %d", runetui.ErrInvalidDimension, cells)
+		}
+		d.value = runetui.DimensionFixed(cells)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: %s is not a number or string", runetui.ErrInvalidDimension, data)
+	}
+	if s == "auto" {
+		d.value = runetui.DimensionAuto()
+		return nil
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(s, "%"))
+	if !strings.HasSuffix(s, "%") || err != nil || percent < 0 || percent > 100 {
+		return fmt.Errorf("%w: %q is not auto or a percentage from 0%% to 100%%", runetui.ErrInvalidDimension, s)
+	}
+	d.value = runetui.DimensionPercent(percent)
+	return nil
+}
+
+// spacing decodes a runetui.Spacing from a number applied to all sides or an
+// object with top, right, bottom and left fields.
+type spacing struct {
+	value runetui.Spacing
+}
+
+func (s *spacing) UnmarshalJSON(data []byte) error {
+	var all int
+	if err := json.Unmarshal(data, &all); err == nil {
+		s.value = runetui.SpacingAll(all)
+		return nil
+	}
+
+	var sides struct {
+		Top    int `json:"top"`
+		Right  int `json:"right"`
+		Bottom int `json:"bottom"`
+		Left   int `json:"left"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&sides); err != nil {
+		return fmt.Errorf("spacing must be a number or an object with top, right, bottom and left: %v", err)
+	}
+	s.value = runetui.SpacingCSSLike(sides.Top, sides.Right, sides.Bottom, sides.Left)
+	return nil
+}
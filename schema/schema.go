@@ -0,0 +1,127 @@
+// Package schema builds RuneTUI component trees from declarative JSON, so
+// dashboards can be configured without Go code.
+//
+// A document is a single component object with a type, optional props and,
+// for containers, children:
+//
+//	{
+//	  "type": "Box",
+//	  "props": {"direction": "column", "border": "rounded", "padding": 1},
+//	  "children": [
+//	    {"type": "Text", "content": "Hello", "props": {"bold": true}}
+//	  ]
+//	}
+//
+// Example usage:
+//
+//	root, err := schema.Parse(data)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	app := runetui.New(func() runetui.Component { return root })
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/runetui/runetui"
+)
+
+// ErrUnknownType is returned when a document uses a type that is not registered.
+var ErrUnknownType = errors.New("unknown component type")
+
+// ErrInvalidSchema is returned when a document does not match the schema.
+var ErrInvalidSchema = errors.New("invalid schema")
+
+// ComponentSchema is the JSON form of a single component.
+type ComponentSchema struct {
+	Type     string            `json:"type"`
+	Key      string            `json:"key,omitempty"`
+	Content  string            `json:"content,omitempty"`
+	Props    json.RawMessage   `json:"props,omitempty"`
+	Children []ComponentSchema `json:"children,omitempty"`
+}
+
+// Builder creates a component from its schema and its already built children.
+type Builder func(s ComponentSchema, children []runetui.Component) (runetui.Component, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Builder{
+		"Box":    buildBox,
+		"Text":   buildText,
+		"Spacer": buildSpacer,
+	}
+)
+
+// Register adds a builder for the given type name, replacing any existing
+// one. Use it to make custom components available to documents.
+func Register(typeName string, builder Builder) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[typeName] = builder
+}
+
+// Types returns the registered type names in sorted order.
+func Types() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookup returns the builder registered for typeName.
+func lookup(typeName string) (Builder, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	builder, ok := registry[typeName]
+	return builder, ok
+}
+
+// Parse validates a JSON document and builds the component tree it describes.
+func Parse(data []byte) (runetui.Component, error) {
+	if err := Validate(data); err != nil {
+		return nil, err
+	}
+	var s ComponentSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	return Build(s)
+}
+
+// Build creates the component tree described by s.
+func Build(s ComponentSchema) (runetui.Component, error) {
+	return build(s, s.Type)
+}
+
+// build creates the component for s; path locates it in errors.
+func build(s ComponentSchema, path string) (runetui.Component, error) {
+	builder, ok := lookup(s.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q at %s", ErrUnknownType, s.Type, path)
+	}
+
+	children := make([]runetui.Component, 0, len(s.Children))
+	for i, child := range s.Children {
+		c, err := build(child, fmt.Sprintf("%s.children[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, c)
+	}
+
+	c, err := builder(s, children)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/runetui/runetui"
+	rtest "github.com/runetui/runetui/testing"
+)
+
+func TestParse_BoxWithTextChildren_BuildsTree(t *testing.T) {
+	data := []byte(`{
+		"type": "Box",
+		"key": "panel",
+		"props": {"direction": "row", "border": "rounded", "gap": 1},
+		"children": [
+			{"type": "Text", "content": "Hello"},
+			{"type": "Text", "content": "World", "props": {"bold": true}}
+		]
+	}`)
+
+	root, err := Parse(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if root.Key() != "panel" || len(root.Children()) != 2 {
+		t.Fatalf("expected keyed box with 2 children, got key %q and %d children", root.Key(), len(root.Children()))
+	}
+	tree := runetui.NewLayoutEngine(80, 24).CalculateLayout(root)
+	if tree.Layout.Width != 2+5+1+5 || tree.Layout.Height != 3 {
+		t.Errorf("expected 13x3 row box, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+	output := rtest.RenderPlain(func() runetui.Component { return root }, 80, 24)
+	runetui.AssertContainsText(t, output, "╭")
+	runetui.AssertContainsText(t, output, "World")
+}
+
+func TestParse_Dimensions_AcceptCellsPercentAndAuto(t *testing.T) {
+	data := []byte(`{"type": "Box", "props": {"width": "50%", "height": 3, "padding": {"left": 2}},
+		"children": [{"type": "Box", "props": {"width": "auto"}}]}`)
+
+	root, err := Parse(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	size := root.Measure(80, 24)
+	if size.Width != 40 || size.Height != 3 {
+		t.Errorf("expected 40x3, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestParse_Spacer_BuildsFixedAndFlexSpacers(t *testing.T) {
+	root, err := Parse([]byte(`{"type": "Box", "props": {"direction": "row"}, "children": [
+		{"type": "Spacer", "props": {"size": 3, "axis": "horizontal"}},
+		{"type": "Spacer"}
+	]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if size := root.Children()[0].Measure(80, 24); size.Width != 3 {
+		t.Errorf("expected fixed spacer width 3, got %d", size.Width)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected error
+	}{
+		{"malformed json", `{"type":`, ErrInvalidSchema},
+		{"unknown type", `{"type": "Gauge"}`, ErrUnknownType},
+		{"unknown nested type", `{"type": "Box", "children": [{"type": "Gauge"}]}`, ErrUnknownType},
+		{"missing type", `{"content": "x"}`, ErrInvalidSchema},
+		{"unknown field", `{"type": "Text", "colour": "red"}`, ErrInvalidSchema},
+		{"unknown prop", `{"type": "Text", "props": {"colour": "red"}}`, ErrInvalidSchema},
+		{"text children", `{"type": "Text", "children": [{"type": "Text"}]}`, ErrInvalidSchema},
+		{"bad direction", `{"type": "Box", "props": {"direction": "diagonal"}}`, runetui.ErrInvalidDirection},
+		{"bad border", `{"type": "Box", "props": {"border": "dotted"}}`, runetui.ErrInvalidBorderStyle},
+		{"bad wrap", `{"type": "Text", "props": {"wrap": "loose"}}`, runetui.ErrInvalidWrapMode},
+		{"bad percent", `{"type": "Box", "props": {"width": "150%"}}`, runetui.ErrInvalidDimension},
+		{"children not array", `{"type": "Box", "children": {}}`, ErrInvalidSchema},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestParse_ErrorNamesPath(t *testing.T) {
+	_, err := Parse([]byte(`{"type": "Box", "children": [{"type": "Text"}, {"type": "Box", "props": {"align": "middle"}}]}`))
+
+	if err == nil || err.Error()[:len("Box.children[1]")] != "Box.children[1]" {
+		t.Errorf("expected error to start with the component path, got %v", err)
+	}
+}
+
+func TestRegister_CustomType_IsBuilt(t *testing.T) {
+	Register("Banner", func(s ComponentSchema, children []runetui.Component) (runetui.Component, error) {
+		return runetui.Text("** "+s.Content+" **", runetui.TextProps{Key: s.Key}), nil
+	})
+
+	root, err := Parse([]byte(`{"type": "Banner", "content": "Alert"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := rtest.RenderPlain(func() runetui.Component { return root }, 80, 24)
+	runetui.AssertContainsText(t, output, "** Alert **")
+	found := false
+	for _, name := range Types() {
+		found = found || name == "Banner"
+	}
+	if !found {
+		t.Errorf("expected Banner in Types(), got %v", Types())
+	}
+}
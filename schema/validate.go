@@ -0,0 +1,90 @@
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//go:embed schema.json
+var jsonSchema []byte
+
+// JSONSchema returns the JSON Schema (draft-07) describing documents accepted
+// by Parse, for use by editors and external validators. Custom types added
+// with Register are accepted by Parse but not described by it.
+func JSONSchema() []byte {
+	return append([]byte(nil), jsonSchema...)
+}
+
+// componentFields are the fields allowed on a component object.
+var componentFields = map[string]bool{
+	"type": true, "key": true, "content": true, "props": true, "children": true,
+}
+
+// Validate reports whether data is a well-formed component document: every
+// component is an object with a registered type, no unknown fields, and
+// fields of the expected JSON types. Props are checked when the tree is built.
+// Errors wrap ErrInvalidSchema or ErrUnknownType and name the offending path.
+func Validate(data []byte) error {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	return validateComponent(doc, "$")
+}
+
+// validateComponent checks a decoded component object at path.
+func validateComponent(v any, path string) error {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: %s must be an object", ErrInvalidSchema, path)
+	}
+
+	var unknown []string
+	for field := range obj {
+		if !componentFields[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%w: %s has unknown fields %s", ErrInvalidSchema, path, strings.Join(unknown, ", "))
+	}
+
+	typeName, ok := obj["type"].(string)
+	if !ok || typeName == "" {
+		return fmt.Errorf("%w: %s.type must be a non-empty string", ErrInvalidSchema, path)
+	}
+	if _, ok := lookup(typeName); !ok {
+		return fmt.Errorf("%w: %q at %s", ErrUnknownType, typeName, path)
+	}
+	for _, field := range []string{"key", "content"} {
+		if value, present := obj[field]; present {
+			if _, ok := value.(string); !ok {
+				return fmt.Errorf("%w: %s.%s must be a string", ErrInvalidSchema, path, field)
+			}
+		}
+	}
+	if props, present := obj["props"]; present {
+		if _, ok := props.(map[string]any); !ok {
+			return fmt.Errorf("%w: %s.props must be an object", ErrInvalidSchema, path)
+		}
+	}
+
+	children, present := obj["children"]
+	if !present {
+		return nil
+	}
+	list, ok := children.([]any)
+	if !ok {
+		return fmt.Errorf("%w: %s.children must be an array", ErrInvalidSchema, path)
+	}
+	for i, child := range list {
+		if err := validateComponent(child, fmt.Sprintf("%s.children[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
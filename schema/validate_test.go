@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate_WellFormedDocument_ReturnsNil(t *testing.T) {
+	data := []byte(`{"type": "Box", "key": "root", "props": {}, "children": [{"type": "Text", "content": "x"}]}`)
+
+	if err := Validate(data); err != nil {
+		t.Errorf("expected valid document, got %v", err)
+	}
+}
+
+func TestValidate_ReportsPathOfInvalidChild(t *testing.T) {
+	err := Validate([]byte(`{"type": "Box", "children": [{"type": "Text"}, {"type": "Text", "content": 5}]}`))
+
+	if !errors.Is(err, ErrInvalidSchema) || !strings.Contains(err.Error(), "$.children[1].content") {
+		t.Errorf("expected error naming $.children[1].content, got %v", err)
+	}
+}
+
+func TestValidate_NonObject_ReturnsError(t *testing.T) {
+	for _, data := range []string{`[]`, `"Box"`, `{"type": "Box", "children": ["Text"]}`, `{"type": "Box", "props": []}`} {
+		if err := Validate([]byte(data)); !errors.Is(err, ErrInvalidSchema) {
+			t.Errorf("%s: expected ErrInvalidSchema, got %v", data, err)
+		}
+	}
+}
+
+func TestJSONSchema_IsValidJSONAndCoversBuiltinTypes(t *testing.T) {
+	var doc struct {
+		Definitions map[string]json.RawMessage `json:"definitions"`
+	}
+	if err := json.Unmarshal(JSONSchema(), &doc); err != nil {
+		t.Fatalf("expected valid JSON, got %v", err)
+	}
+
+	for _, name := range []string{"component", "boxProps", "textProps", "spacerProps"} {
+		if _, ok := doc.Definitions[name]; !ok {
+			t.Errorf("expected definition %q", name)
+		}
+	}
+}
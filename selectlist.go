@@ -0,0 +1,124 @@
+package runetui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SelectListProps defines properties for the SelectList component.
+// The selected item uses SelectedColor and SelectedBackground. While the
+// list is focused, CursorChar (default "▶") marks the selected item in a
+// gutter colored with FocusedBorderColor.
+type SelectListProps struct {
+	SelectedIndex      int
+	FocusedBorderColor string
+	SelectedColor      string
+	SelectedBackground string
+	CursorChar         string
+	Focused            bool
+	Key                string
+}
+
+func (SelectListProps) isProps() {}
+
+// selectList is the private implementation of the SelectList component.
+type selectList struct {
+	props SelectListProps
+	items []Component
+	body  Component
+}
+
+// SelectList creates a vertical list of items with one selected item. The
+// application owns SelectedIndex and moves it in its UpdateFunc, typically
+// on up and down keys.
+//
+// Example:
+//
+//	SelectList(SelectListProps{SelectedIndex: selected, Focused: true},
+//	    []string{"Small", "Medium", "Large"})
+func SelectList(props SelectListProps, items []string) Component {
+	rows := make([]Component, len(items))
+	for i, item := range items {
+		style := TextProps{}
+		if i == props.SelectedIndex {
+			style = TextProps{Color: props.SelectedColor, Background: props.SelectedBackground}
+		}
+		rows[i] = Text(item, style)
+	}
+	return newSelectList(props, rows)
+}
+
+// SelectListItem is SelectList with a component per row, for items with
+// icons, badges or other rich content. The selected row is drawn on
+// SelectedBackground; SelectedColor does not apply.
+//
+// Example:
+//
+//	SelectListItem(SelectListProps{SelectedIndex: selected, Focused: true},
+//	    HStack(Text("●", TextProps{Color: "#50FA7B"}), Text(" api")),
+//	    HStack(Text("●", TextProps{Color: "#FF5555"}), Text(" worker")),
+//	)
+func SelectListItem(props SelectListProps, items ...Component) Component {
+	rows := make([]Component, len(items))
+	for i, item := range items {
+		rows[i] = item
+		if i == props.SelectedIndex && props.SelectedBackground != "" {
+			rows[i] = Box(BoxProps{Background: props.SelectedBackground}, item)
+		}
+	}
+	return newSelectList(props, rows)
+}
+
+// newSelectList builds the rows with their cursor gutter.
+func newSelectList(props SelectListProps, items []Component) *selectList {
+	if props.CursorChar == "" {
+		props.CursorChar = "▶"
+	}
+	blank := strings.Repeat(" ", lipgloss.Width(props.CursorChar)) + " "
+
+	rows := make([]Component, len(items))
+	for i, item := range items {
+		gutter := Text(blank)
+		if props.Focused && i == props.SelectedIndex {
+			gutter = Text(props.CursorChar+" ", TextProps{Color: props.FocusedBorderColor})
+		}
+		rows[i] = Box(BoxProps{Direction: Row}, gutter, item)
+	}
+
+	return &selectList{
+		props: props,
+		items: items,
+		body:  Box(BoxProps{Direction: Column}, rows...),
+	}
+}
+
+// Render generates one line per item.
+func (s *selectList) Render(layout Layout) string {
+	return s.body.Render(layout)
+}
+
+// Children returns the item rows.
+func (s *selectList) Children() []Component {
+	return s.body.Children()
+}
+
+// Key returns the unique identifier for this component.
+func (s *selectList) Key() string {
+	return s.props.Key
+}
+
+func (s *selectList) label() string {
+	return "selectlist"
+}
+
+// Measure returns one line per item and the widest item plus the cursor
+// gutter.
+func (s *selectList) Measure(availableWidth, availableHeight int) Size {
+	size := Size{Height: len(s.items)}
+	gutter := lipgloss.Width(s.props.CursorChar) + 1
+	for _, item := range s.items {
+		size.Width = max(size.Width, gutter+item.Measure(availableWidth-gutter, availableHeight).Width)
+	}
+	return size
+}
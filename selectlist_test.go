@@ -0,0 +1,70 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectList_Render_CursorWhenFocused(t *testing.T) {
+	c := SelectList(SelectListProps{SelectedIndex: 1, Focused: true}, []string{"Small", "Medium", "Large"})
+
+	output := StripANSI(c.Render(Layout{Width: 8, Height: 3}))
+
+	expected := "  Small\n▶ Medium\n  Large"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestSelectList_Render_NoCursorWhenUnfocused(t *testing.T) {
+	c := SelectList(SelectListProps{SelectedIndex: 0}, []string{"a", "b"})
+
+	if output := StripANSI(c.Render(Layout{Width: 3, Height: 2})); strings.Contains(output, "▶") {
+		t.Errorf("expected no cursor, got %q", output)
+	}
+}
+
+func TestSelectList_Render_SelectedAndCursorColors(t *testing.T) {
+	c := SelectList(SelectListProps{
+		SelectedIndex:      0,
+		Focused:            true,
+		CursorChar:         ">",
+		FocusedBorderColor: "#FF0000",
+		SelectedColor:      "#00FF00",
+		SelectedBackground: "#0000FF",
+	}, []string{"a", "b"})
+
+	lines := strings.Split(c.Render(Layout{Width: 3, Height: 2}), "\n")
+
+	for _, code := range []string{"38;2;255;0;0", "38;2;0;255;0", "48;2;0;0;255"} {
+		if !strings.Contains(lines[0], code) {
+			t.Errorf("expected %s in selected row %q", code, lines[0])
+		}
+	}
+	if strings.Contains(lines[1], "38;2") {
+		t.Errorf("expected plain unselected row, got %q", lines[1])
+	}
+}
+
+func TestSelectListItem_RendersComponentRows(t *testing.T) {
+	c := SelectListItem(SelectListProps{SelectedIndex: 1, Focused: true},
+		HStack(Text("●"), Text(" api")),
+		HStack(Text("●"), Text(" worker")),
+	)
+
+	output := StripANSI(c.Render(Layout{Width: 10, Height: 2}))
+
+	AssertContainsText(t, output, "  ● api")
+	AssertContainsText(t, output, "▶ ● worker")
+}
+
+func TestSelectList_Measure(t *testing.T) {
+	c := SelectList(SelectListProps{}, []string{"Small", "Medium", "Large"})
+
+	if size := c.Measure(80, 24); size.Width != 8 || size.Height != 3 {
+		t.Errorf("expected 8x3, got %dx%d", size.Width, size.Height)
+	}
+	if size := SelectList(SelectListProps{CursorChar: "->"}, []string{"ab"}).Measure(80, 24); size.Width != 5 {
+		t.Errorf("expected the cursor width counted, got %d", size.Width)
+	}
+}
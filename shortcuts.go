@@ -0,0 +1,54 @@
+package runetui
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// shortcutGap is the number of spaces between shortcut pairs in the footer.
+const shortcutGap = 2
+
+// Shortcut describes a key binding shown in the WithShortcuts footer.
+type Shortcut struct {
+	Key         string
+	Description string
+}
+
+// WithShortcuts adds a help footer below the root component that lists the
+// shortcuts as "[key] description" pairs. The footer spans the full terminal
+// width; pairs that do not fit are omitted from the end.
+//
+// Example:
+//
+//	app := runetui.New(rootFunc, runetui.WithShortcuts(
+//	    runetui.Shortcut{Key: "q", Description: "quit"},
+//	    runetui.Shortcut{Key: "tab", Description: "next field"},
+//	))
+func WithShortcuts(shortcuts ...Shortcut) AppOption {
+	return func(a *App) {
+		a.shortcuts = shortcuts
+	}
+}
+
+// shortcutFooter renders as many shortcut pairs as fit in width.
+func shortcutFooter(shortcuts []Shortcut, width int) Component {
+	var pairs []Component
+	used := 0
+	for _, shortcut := range shortcuts {
+		label := fmt.Sprintf("[%s] %s", shortcut.Key, shortcut.Description)
+		needed := utf8.RuneCountInString(label)
+		if len(pairs) > 0 {
+			needed += shortcutGap
+		}
+		if used+needed > width {
+			break
+		}
+		used += needed
+		pairs = append(pairs, Text(label))
+	}
+
+	return HStackWithProps(StackProps{
+		Gap:   shortcutGap,
+		Width: DimensionPercent(100),
+	}, pairs...)
+}
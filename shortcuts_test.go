@@ -0,0 +1,62 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var testShortcuts = []Shortcut{
+	{Key: "q", Description: "quit"},
+	{Key: "tab", Description: "next field"},
+	{Key: "?", Description: "help"},
+}
+
+func TestShortcutFooter_AllFit_RendersPairsInOrder(t *testing.T) {
+	footer := shortcutFooter(testShortcuts, 80)
+
+	children := footer.Children()
+	if len(children) != 3 {
+		t.Fatalf("expected 3 pairs, got %d", len(children))
+	}
+	if content := children[1].(*text).content; content != "[tab] next field" {
+		t.Errorf("expected %q, got %q", "[tab] next field", content)
+	}
+}
+
+func TestShortcutFooter_Narrow_OmitsPairsThatDoNotFit(t *testing.T) {
+	// "[q] quit" is 8 wide, "  [tab] next field" needs 18 more.
+	footer := shortcutFooter(testShortcuts, 25)
+
+	if len(footer.Children()) != 1 {
+		t.Errorf("expected 1 pair, got %d", len(footer.Children()))
+	}
+	if len(shortcutFooter(testShortcuts, 26).Children()) != 2 {
+		t.Error("expected 2 pairs at exactly 26 columns")
+	}
+}
+
+func TestShortcutFooter_SpansFullWidth(t *testing.T) {
+	tree := NewLayoutEngine(60, 10).CalculateLayout(shortcutFooter(testShortcuts, 60))
+
+	if tree.Layout.Width != 60 {
+		t.Errorf("expected footer width 60, got %d", tree.Layout.Width)
+	}
+}
+
+func TestWithShortcuts_AddsFooterBelowRoot(t *testing.T) {
+	app := New(func() Component { return Text("content") }, WithShortcuts(testShortcuts...))
+	m := app.createModel()
+	m.Update(tea.WindowSizeMsg{Width: 30, Height: 10})
+
+	output := StripANSI(m.View())
+
+	lines := strings.Split(output, "\n")
+	if len(lines) < 2 || !strings.HasPrefix(lines[0], "content") {
+		t.Fatalf("expected content above footer, got %q", output)
+	}
+	if !strings.Contains(lines[1], "[q] quit") || strings.Contains(output, "[?] help") {
+		t.Errorf("expected footer with the pairs that fit in 30 columns, got %q", lines[1])
+	}
+}
@@ -2,7 +2,11 @@ package runetui
 
 // Spacer creates a fixed-size spacer component.
 // Returns an empty Box with both width and height set to the specified size.
-// The layout engine will use the appropriate dimension based on parent direction.
+// Because the spacer cannot see its parent's direction, it also takes up size
+// on the cross axis, which stretches a Row's height or a Column's width.
+//
+// Deprecated: Use DirectionalSpacer, or HorizontalSpacer in rows and
+// VerticalSpacer in columns.
 func Spacer(size int) Component {
 	return Box(BoxProps{
 		Width:  DimensionFixed(size),
@@ -10,8 +14,79 @@ func Spacer(size int) Component {
 	})
 }
 
+// HorizontalSpacer creates a spacer that is size cells wide and has no height,
+// for use in Row containers.
+func HorizontalSpacer(size int) Component {
+	return Box(BoxProps{
+		Width:  DimensionFixed(size),
+		Height: DimensionAuto(),
+	})
+}
+
+// VerticalSpacer creates a spacer that is size lines tall and has no width,
+// for use in Column containers.
+func VerticalSpacer(size int) Component {
+	return Box(BoxProps{
+		Width:  DimensionAuto(),
+		Height: DimensionFixed(size),
+	})
+}
+
+// directionalSpacer is the private implementation of DirectionalSpacer.
+type directionalSpacer struct {
+	size int
+}
+
+// DirectionalSpacer creates a spacer that takes up size cells along its
+// parent's main axis and nothing on the cross axis: it is size cells wide in
+// a Row and size lines tall in a Column. Outside a container it measures as
+// in a Column.
+func DirectionalSpacer(size int) Component {
+	return &directionalSpacer{size: size}
+}
+
+// Render returns nothing; the surrounding layout provides the blank space.
+func (s *directionalSpacer) Render(layout Layout) string {
+	return ""
+}
+
+// Children returns no children.
+func (s *directionalSpacer) Children() []Component {
+	return nil
+}
+
+// Key returns an empty key; spacers are not addressable.
+func (s *directionalSpacer) Key() string {
+	return ""
+}
+
+func (s *directionalSpacer) label() string {
+	return "spacer"
+}
+
+// Measure returns the size of the spacer in a Column.
+func (s *directionalSpacer) Measure(availableWidth, availableHeight int) Size {
+	return s.measureIn(Column, availableWidth, availableHeight)
+}
+
+// measureIn returns size along the main axis of direction.
+func (s *directionalSpacer) measureIn(direction Direction, availableWidth, availableHeight int) Size {
+	if direction == Row {
+		return Size{Width: s.size}
+	}
+	return Size{Height: s.size}
+}
+
 // FlexSpacer creates a flexible spacer that fills available space.
 // Returns an empty Box with FlexGrow set to 1.0.
 func FlexSpacer() Component {
 	return Box(BoxProps{FlexGrow: 1.0})
 }
+
+// FlexSpacerWithGrow creates a flexible spacer with the given FlexGrow factor.
+// Free space in the parent is shared between flex children in proportion to
+// their factors, so two spacers with factors 2 and 1 split it 2:1.
+// A row without a fixed width that holds one spans the available width.
+func FlexSpacerWithGrow(factor float64) Component {
+	return Box(BoxProps{FlexGrow: factor})
+}
@@ -1,6 +1,9 @@
 package runetui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestSpacer_WithFixedSize_CreatesBoxWithFixedWidth(t *testing.T) {
 	spacer := Spacer(10)
@@ -168,3 +171,153 @@ func TestFlexSpacer_HasNoChildren(t *testing.T) {
 		t.Errorf("expected 0 children, got %d", got)
 	}
 }
+
+func TestDirectionalSpacers_UseAutoCrossAxis(t *testing.T) {
+	horizontal := HorizontalSpacer(4).(*box)
+	vertical := VerticalSpacer(4).(*box)
+
+	if _, ok := horizontal.props.Height.(dimensionAuto); !ok {
+		t.Error("expected HorizontalSpacer height to be auto")
+	}
+	if _, ok := vertical.props.Width.(dimensionAuto); !ok {
+		t.Error("expected VerticalSpacer width to be auto")
+	}
+}
+
+func TestFlexSpacerWithGrow_SetsFactor(t *testing.T) {
+	spacer := FlexSpacerWithGrow(2.5).(*box)
+
+	if spacer.props.FlexGrow != 2.5 {
+		t.Errorf("expected FlexGrow 2.5, got %f", spacer.props.FlexGrow)
+	}
+}
+
+func TestFlexSpacerWithGrow_InRow_DistributesSpaceByRatio(t *testing.T) {
+	row := HStackWithProps(StackProps{Width: DimensionFixed(10)},
+		FlexSpacerWithGrow(2), Text("X"), FlexSpacerWithGrow(1))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(row)
+
+	left, x, right := tree.Children[0].Layout, tree.Children[1].Layout, tree.Children[2].Layout
+	if left.Width != 6 || right.Width != 3 {
+		t.Errorf("expected spacer widths 6 and 3, got %d and %d", left.Width, right.Width)
+	}
+	if x.X != 6 || right.X != 7 {
+		t.Errorf("expected X at 6 and right spacer at 7, got %d and %d", x.X, right.X)
+	}
+}
+
+func TestFlexSpacerWithGrow_InHStack_RendersFreeWidthByRatio(t *testing.T) {
+	for _, root := range []Component{
+		HStack(Text("A"), FlexSpacerWithGrow(2), Text("B"), FlexSpacerWithGrow(1), Text("C")),
+		VStack(Text("title"), HStack(Text("A"), FlexSpacerWithGrow(2), Text("B"), FlexSpacerWithGrow(1), Text("C"))),
+	} {
+		output := StripANSI(renderTree(NewLayoutEngine(33, 24).CalculateLayout(root)))
+
+		row := strings.Split(output, "\n")
+		line := row[len(row)-1]
+		if strings.Index(line, "A") != 0 || strings.Index(line, "B") != 21 || strings.Index(line, "C") != 32 {
+			t.Errorf("expected A, B and C at columns 0, 21 and 32, got %q", line)
+		}
+	}
+}
+
+func TestFlexSpacerWithGrow_InRowInsideRow_KeepsContentWidth(t *testing.T) {
+	row := HStack(Text("a"), HStack(Text("b"), FlexSpacerWithGrow(1)))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(row)
+
+	if tree.Layout.Width != 2 {
+		t.Errorf("expected the nested row to keep its content width, got row width %d", tree.Layout.Width)
+	}
+}
+
+func TestFlexSpacerWithGrow_InColumn_DistributesSpaceByRatio(t *testing.T) {
+	column := VStackWithProps(StackProps{Height: DimensionFixed(7)},
+		Text("top"), FlexSpacerWithGrow(1), Text("bottom"), FlexSpacerWithGrow(3))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(column)
+
+	if tree.Children[1].Layout.Height != 1 || tree.Children[3].Layout.Height != 3 {
+		t.Errorf("expected spacer heights 1 and 3, got %d and %d",
+			tree.Children[1].Layout.Height, tree.Children[3].Layout.Height)
+	}
+	if tree.Children[2].Layout.Y != 2 {
+		t.Errorf("expected bottom at Y=2, got %d", tree.Children[2].Layout.Y)
+	}
+}
+
+func TestHorizontalSpacer_InRow_AddsWidthOnly(t *testing.T) {
+	row := HStack(Text("a"), HorizontalSpacer(3), Text("b"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(row)
+
+	if tree.Layout.Width != 5 || tree.Layout.Height != 1 {
+		t.Errorf("expected row 5x1, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+	if tree.Children[2].Layout.X != 4 {
+		t.Errorf("expected b at X=4, got %d", tree.Children[2].Layout.X)
+	}
+}
+
+func TestVerticalSpacer_InColumn_AddsHeightOnly(t *testing.T) {
+	column := VStack(Text("abc"), VerticalSpacer(2), Text("d"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(column)
+
+	if tree.Layout.Width != 3 || tree.Layout.Height != 4 {
+		t.Errorf("expected column 3x4, got %dx%d", tree.Layout.Width, tree.Layout.Height)
+	}
+	if tree.Children[2].Layout.Y != 3 {
+		t.Errorf("expected d at Y=3, got %d", tree.Children[2].Layout.Y)
+	}
+}
+
+func TestDirectionalSpacer_InRow_TakesWidthOnly(t *testing.T) {
+	row := Box(BoxProps{Direction: Row}, Text("a"), DirectionalSpacer(3), Text("b"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(row)
+
+	spacer := tree.Children[1].Layout
+	if spacer.Width != 3 || spacer.Height != 0 {
+		t.Errorf("expected 3x0 spacer, got %dx%d", spacer.Width, spacer.Height)
+	}
+	if tree.Children[2].Layout.X != 4 || tree.Layout.Height != 1 {
+		t.Errorf("expected b at x=4 in a 1-line row, got x=%d height=%d", tree.Children[2].Layout.X, tree.Layout.Height)
+	}
+	if output := StripANSI(row.Render(tree.Layout)); output != "a   b" {
+		t.Errorf("expected %q, got %q", "a   b", output)
+	}
+}
+
+func TestDirectionalSpacer_InColumn_TakesHeightOnly(t *testing.T) {
+	column := Box(BoxProps{Direction: Column}, Text("a"), DirectionalSpacer(2), Text("b"))
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(column)
+
+	spacer := tree.Children[1].Layout
+	if spacer.Width != 0 || spacer.Height != 2 {
+		t.Errorf("expected 0x2 spacer, got %dx%d", spacer.Width, spacer.Height)
+	}
+	if tree.Children[2].Layout.Y != 3 || tree.Layout.Width != 1 {
+		t.Errorf("expected b at y=3 in a 1-wide column, got y=%d width=%d", tree.Children[2].Layout.Y, tree.Layout.Width)
+	}
+}
+
+func TestDirectionalSpacer_Standalone_MeasuresAsColumn(t *testing.T) {
+	size := DirectionalSpacer(4).Measure(80, 24)
+
+	if size.Width != 0 || size.Height != 4 {
+		t.Errorf("expected 0x4, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestDirectionalSpacer_NestedBoxMeasure_UsesParentDirection(t *testing.T) {
+	row := Box(BoxProps{Direction: Row}, Text("a"), DirectionalSpacer(5))
+
+	size := row.Measure(80, 24)
+
+	if size.Width != 6 || size.Height != 1 {
+		t.Errorf("expected 6x1, got %dx%d", size.Width, size.Height)
+	}
+}
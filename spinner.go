@@ -0,0 +1,111 @@
+package runetui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Frame sets for SpinnerProps.Frames.
+var (
+	// SpinnerDots is a rotating Braille dot, the default frame set.
+	SpinnerDots = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	// SpinnerLine is a rotating ASCII line.
+	SpinnerLine = []string{"-", "\\", "|", "/"}
+	// SpinnerBounce is a dot bouncing up and down.
+	SpinnerBounce = []string{"⠁", "⠂", "⠄", "⡀", "⠄", "⠂"}
+)
+
+// SpinnerProps defines properties for the Spinner component.
+// Frame is the index of the frame to show and wraps around Frames, which
+// defaults to SpinnerDots.
+type SpinnerProps struct {
+	Frames     []string
+	Frame      int
+	Color      string
+	Label      string
+	LabelColor string
+	Key        string
+}
+
+func (SpinnerProps) isProps() {}
+
+// spinner is the private implementation of the Spinner component.
+type spinner struct {
+	props SpinnerProps
+}
+
+// Spinner creates a one-line activity indicator: the current frame followed
+// by the label. The application owns Frame and advances it on each tick,
+// typically scheduled with SpinnerTick.
+//
+// Example:
+//
+//	type tickMsg struct{}
+//
+//	update := func(msg tea.Msg) tea.Cmd {
+//	    if _, ok := msg.(tickMsg); ok {
+//	        frame++
+//	        return SpinnerTick(80*time.Millisecond, func() tea.Msg { return tickMsg{} })
+//	    }
+//	    return nil
+//	}
+//	view := Spinner(SpinnerProps{Frame: frame, Label: "Loading..."})
+func Spinner(props SpinnerProps) Component {
+	if len(props.Frames) == 0 {
+		props.Frames = SpinnerDots
+	}
+	return &spinner{props: props}
+}
+
+// SpinnerTick returns a command that dispatches the message from msgFunc
+// after duration. Return it again when handling that message to keep the
+// spinner moving.
+func SpinnerTick(duration time.Duration, msgFunc func() tea.Msg) tea.Cmd {
+	return tea.Tick(duration, func(time.Time) tea.Msg {
+		return msgFunc()
+	})
+}
+
+// frame returns the frame for props.Frame, wrapping in both directions.
+func (s *spinner) frame() string {
+	n := len(s.props.Frames)
+	return s.props.Frames[((s.props.Frame%n)+n)%n]
+}
+
+// Render generates the frame and label.
+func (s *spinner) Render(layout Layout) string {
+	output := Text(s.frame(), TextProps{Color: s.props.Color}).Render(Layout{})
+	if s.props.Label != "" {
+		output += " " + Text(s.props.Label, TextProps{Color: s.props.LabelColor}).Render(Layout{})
+	}
+	return output
+}
+
+// Children returns an empty slice since spinners have no children.
+func (s *spinner) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (s *spinner) Key() string {
+	return s.props.Key
+}
+
+func (s *spinner) label() string {
+	return "spinner"
+}
+
+// Measure returns the width of the widest frame plus the label, on one line.
+// Using the widest frame keeps the size stable while the spinner animates.
+func (s *spinner) Measure(availableWidth, availableHeight int) Size {
+	width := 0
+	for _, frame := range s.props.Frames {
+		width = max(width, lipgloss.Width(frame))
+	}
+	if s.props.Label != "" {
+		width += 1 + lipgloss.Width(s.props.Label)
+	}
+	return Size{Width: width, Height: 1}
+}
@@ -0,0 +1,55 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSpinner_Render_FrameAndLabel(t *testing.T) {
+	output := StripANSI(Spinner(SpinnerProps{Frame: 2, Label: "Loading"}).Render(Layout{}))
+
+	if output != SpinnerDots[2]+" Loading" {
+		t.Errorf("unexpected spinner %q", output)
+	}
+}
+
+func TestSpinner_Render_FrameWrapsAround(t *testing.T) {
+	tests := map[int]string{4: "-", 5: "\\", -1: "/"}
+
+	for frame, expected := range tests {
+		output := Spinner(SpinnerProps{Frames: SpinnerLine, Frame: frame}).Render(Layout{})
+		if output != expected {
+			t.Errorf("frame %d: expected %q, got %q", frame, expected, output)
+		}
+	}
+}
+
+func TestSpinner_Render_Colors(t *testing.T) {
+	output := Spinner(SpinnerProps{Color: "#FF0000", Label: "Wait", LabelColor: "#00FF00"}).Render(Layout{})
+
+	if !strings.Contains(output, "38;2;255;0;0") || !strings.Contains(output, "38;2;0;255;0") {
+		t.Errorf("expected frame and label colors, got %q", output)
+	}
+}
+
+func TestSpinner_Measure(t *testing.T) {
+	if size := Spinner(SpinnerProps{Label: "Loading"}).Measure(80, 24); size != (Size{Width: 9, Height: 1}) {
+		t.Errorf("expected 9x1, got %+v", size)
+	}
+	if size := Spinner(SpinnerProps{Frames: []string{".", "..", "..."}}).Measure(80, 24); size.Width != 3 {
+		t.Errorf("expected the widest frame, got %+v", size)
+	}
+}
+
+func TestSpinnerTick_DispatchesMessage(t *testing.T) {
+	type tickMsg struct{}
+
+	cmd := SpinnerTick(time.Millisecond, func() tea.Msg { return tickMsg{} })
+
+	if msg := cmd(); msg != (tickMsg{}) {
+		t.Errorf("expected tickMsg, got %v", msg)
+	}
+}
@@ -0,0 +1,28 @@
+// Command hello serves a RuneTUI app over SSH. Run it, then connect from
+// another terminal with: ssh -p 23234 localhost
+package main
+
+import (
+	"log"
+
+	"github.com/runetui/runetui"
+	"github.com/runetui/runetui/ssh"
+)
+
+func main() {
+	app := runetui.New(func() runetui.Component {
+		return runetui.Box(
+			runetui.BoxProps{Border: runetui.BorderSingle},
+			runetui.PaddedBox(
+				runetui.SpacingAll(2),
+				runetui.Text("Hello over SSH!", runetui.TextProps{Bold: true}),
+				runetui.Text("Press Ctrl+C to disconnect"),
+			),
+		)
+	})
+
+	log.Printf("listening on %s", ssh.DefaultAddress)
+	if err := ssh.Serve(app); err != nil {
+		log.Fatal(err)
+	}
+}
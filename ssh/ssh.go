@@ -0,0 +1,152 @@
+// Package ssh serves RuneTUI apps over SSH using wish.
+//
+// Every connection runs its own Bubble Tea program, sized from the client's
+// PTY and resized when the client's window changes. Sessions share the app's
+// options and its root, update and init functions, but each has its own
+// layout and static zone, so one client's terminal size never affects
+// another's.
+//
+// It lives in its own module so applications that never serve over SSH do not
+// depend on an SSH stack.
+//
+// Example usage:
+//
+//	app := runetui.New(rootFunc, runetui.WithAltScreen())
+//	if err := ssh.Serve(app, ssh.WithAddress(":2222")); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	// From another terminal: ssh -p 2222 localhost
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	tea "github.com/charmbracelet/bubbletea"
+	charmssh "github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/runetui/runetui"
+)
+
+// DefaultAddress is the address Serve listens on when WithAddress is not used.
+const DefaultAddress = ":23234"
+
+// DefaultHostKeyPath is the host key file used when WithHostKeyPath is not
+// used. The key is generated on first start if the file does not exist.
+const DefaultHostKeyPath = ".ssh/id_ed25519"
+
+// Option configures Serve.
+type Option func(*config)
+
+type config struct {
+	address     string
+	hostKeyPath string
+	listener    net.Listener
+	ctx         context.Context
+}
+
+// WithAddress sets the host and port the server listens on.
+func WithAddress(address string) Option {
+	return func(c *config) {
+		c.address = address
+	}
+}
+
+// WithHostKeyPath sets the file holding the server's host key. A new ed25519
+// key is written there if the file does not exist.
+func WithHostKeyPath(path string) Option {
+	return func(c *config) {
+		c.hostKeyPath = path
+	}
+}
+
+// WithListener serves connections accepted by l instead of listening on the
+// configured address. Use it to listen on a random port in tests.
+func WithListener(l net.Listener) Option {
+	return func(c *config) {
+		c.listener = l
+	}
+}
+
+// WithContext stops the server when ctx is cancelled. Serve then returns nil.
+func WithContext(ctx context.Context) Option {
+	return func(c *config) {
+		c.ctx = ctx
+	}
+}
+
+// Serve starts an SSH server and blocks until it stops. Each session that
+// requests a PTY runs app in its own Bubble Tea program; sessions without a
+// PTY are refused with a message.
+func Serve(app *runetui.App, opts ...Option) error {
+	cfg := config{
+		address:     DefaultAddress,
+		hostKeyPath: DefaultHostKeyPath,
+		ctx:         context.Background(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	server, err := wish.NewServer(
+		wish.WithAddress(cfg.address),
+		wish.WithHostKeyPath(cfg.hostKeyPath),
+		wish.WithMiddleware(middleware(app)),
+	)
+	if err != nil {
+		return fmt.Errorf("runetui/ssh: creating server: %w", err)
+	}
+	stop := context.AfterFunc(cfg.ctx, func() { server.Close() })
+	defer stop()
+
+	if cfg.listener != nil {
+		err = server.Serve(cfg.listener)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if errors.Is(err, charmssh.ErrServerClosed) && cfg.ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// middleware runs a session of app for each SSH session.
+func middleware(app *runetui.App) wish.Middleware {
+	return func(next charmssh.Handler) charmssh.Handler {
+		return func(s charmssh.Session) {
+			pty, windows, ok := s.Pty()
+			if !ok {
+				wish.Fatalln(s, "runetui/ssh: a terminal is required, connect with ssh -t")
+				return
+			}
+			session := app.Session(
+				runetui.WithIO(s, s),
+				runetui.WithSize(pty.Window.Width, pty.Window.Height),
+			)
+			go forwardResizes(s.Context(), session, windows)
+			if err := session.RunContext(s.Context()); err != nil && s.Context().Err() == nil {
+				wish.Errorln(s, err)
+			}
+			next(s)
+		}
+	}
+}
+
+// forwardResizes sends the client's window changes to the session until ctx
+// is done.
+func forwardResizes(ctx context.Context, session *runetui.App, windows <-chan charmssh.Window) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case w, ok := <-windows:
+			if !ok {
+				return
+			}
+			session.SendMessage(tea.WindowSizeMsg{Width: w.Width, Height: w.Height})
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/runetui/runetui"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func startServer(t *testing.T, app *runetui.App) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- Serve(app,
+			WithListener(listener),
+			WithHostKeyPath(filepath.Join(t.TempDir(), "host_key")),
+			WithContext(ctx),
+		)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-served:
+			if err != nil {
+				t.Errorf("expected Serve to return nil after cancellation, got %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("expected Serve to return after cancellation")
+		}
+	})
+	return listener.Addr().String()
+}
+
+func dial(t *testing.T, addr string) *gossh.Client {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("dialing %s: %v", addr, err)
+	}
+	clientConn, chans, reqs, err := gossh.NewClientConn(conn, addr, &gossh.ClientConfig{
+		User:            "tester",
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("ssh handshake: %v", err)
+	}
+	client := gossh.NewClient(clientConn, chans, reqs)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// syncBuffer is a bytes.Buffer safe to write from the SSH session while the
+// test reads it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func waitFor(t *testing.T, out *syncBuffer, text string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(out.String(), text) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected output to contain %q, got %q", text, out.String())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServe_SessionWithPTY_RendersApp(t *testing.T) {
+	app := runetui.New(func() runetui.Component {
+		return runetui.Text("Hello over SSH")
+	})
+	client := dial(t, startServer(t, app))
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("opening session: %v", err)
+	}
+	out := &syncBuffer{}
+	session.Stdout = out
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		t.Fatalf("opening stdin: %v", err)
+	}
+	if err := session.RequestPty("xterm", 24, 80, gossh.TerminalModes{}); err != nil {
+		t.Fatalf("requesting PTY: %v", err)
+	}
+	if err := session.Shell(); err != nil {
+		t.Fatalf("starting shell: %v", err)
+	}
+
+	waitFor(t, out, "Hello over SSH")
+
+	if _, err := io.WriteString(stdin, "\x03"); err != nil {
+		t.Fatalf("sending Ctrl+C: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- session.Wait() }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Error("expected the session to end after Ctrl+C")
+	}
+}
+
+func TestServe_TwoSessions_AreSizedIndependently(t *testing.T) {
+	app := runetui.New(func() runetui.Component {
+		return runetui.Box(runetui.BoxProps{
+			Width:  runetui.DimensionPercent(100),
+			Border: runetui.BorderSingle,
+		}, runetui.Text("x"))
+	})
+	client := dial(t, startServer(t, app))
+
+	narrow := openPTY(t, client, 20)
+	wide := openPTY(t, client, 40)
+
+	waitFor(t, narrow, "┌"+strings.Repeat("─", 18)+"┐")
+	waitFor(t, wide, "┌"+strings.Repeat("─", 38)+"┐")
+}
+
+func openPTY(t *testing.T, client *gossh.Client, width int) *syncBuffer {
+	t.Helper()
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("opening session: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+	out := &syncBuffer{}
+	session.Stdout = out
+	if err := session.RequestPty("xterm", 10, width, gossh.TerminalModes{}); err != nil {
+		t.Fatalf("requesting PTY: %v", err)
+	}
+	if err := session.Shell(); err != nil {
+		t.Fatalf("starting shell: %v", err)
+	}
+	return out
+}
+
+func TestServe_SessionWithoutPTY_IsRefused(t *testing.T) {
+	app := runetui.New(func() runetui.Component {
+		return runetui.Text("Hello over SSH")
+	})
+	client := dial(t, startServer(t, app))
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("opening session: %v", err)
+	}
+	output, err := session.CombinedOutput("")
+
+	if err == nil {
+		t.Error("expected the session to exit with an error")
+	}
+	if !strings.Contains(string(output), "terminal is required") {
+		t.Errorf("expected a message asking for a terminal, got %q", output)
+	}
+}
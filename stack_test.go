@@ -1,6 +1,9 @@
 package runetui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 // Step 1: Test that VStack creates a component
 func TestVStack_WithNoChildren_CanBeCreated(t *testing.T) {
@@ -253,3 +256,16 @@ func TestHStackWithProps_WithJustifyCenter_AppliesJustification(t *testing.T) {
 		t.Fatal("HStackWithProps should not return nil")
 	}
 }
+
+func TestStacks_NilChildren_RenderWithoutPanic(t *testing.T) {
+	var conditional Component
+
+	vstack := VStack(conditional, Text("always"))
+	hstack := HStackWithProps(StackProps{Gap: 1}, Text("left"), conditional)
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(VStack(vstack, hstack))
+	output := StripANSI(renderTree(tree))
+	if !strings.Contains(output, "always") || !strings.Contains(output, "left") {
+		t.Errorf("expected both stacks to render, got %q", output)
+	}
+}
@@ -58,6 +58,10 @@ func (s *static) Key() string {
 	return s.props.Key
 }
 
+func (s *static) label() string {
+	return "static"
+}
+
 func (s *static) Measure(availableWidth, availableHeight int) Size {
 	items := s.itemsFunc()
 	totalHeight := 0
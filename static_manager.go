@@ -1,33 +1,105 @@
 package runetui
 
-import "strings"
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// warningOutput receives warnings about likely mistakes found while rendering.
+var warningOutput io.Writer = os.Stderr
 
 type StaticManager struct {
+	mu           sync.Mutex
 	staticBuffer []string
-	staticKeys   map[string]int
+	staticKeys   map[string]staticZone
+	warnedNoKey  bool
+}
+
+// staticZone is the range of staticBuffer lines appended for one key.
+type staticZone struct {
+	start int
+	end   int
 }
 
 func NewStaticManager() *StaticManager {
 	return &StaticManager{
 		staticBuffer: []string{},
-		staticKeys:   make(map[string]int),
+		staticKeys:   make(map[string]staticZone),
 	}
 }
 
 func (sm *StaticManager) AppendStatic(key string, content []string) int {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if key == "" && !sm.warnedNoKey {
+		sm.warnedNoKey = true
+		fmt.Fprintln(warningOutput, "runetui: warning: static zone has an empty key; zones without distinct keys share one entry and later ones are dropped")
+	}
 	if _, exists := sm.staticKeys[key]; exists {
 		return 0
 	}
+	start := len(sm.staticBuffer)
 	sm.staticBuffer = append(sm.staticBuffer, content...)
-	sm.staticKeys[key] = len(sm.staticBuffer)
+	sm.staticKeys[key] = staticZone{start: start, end: len(sm.staticBuffer)}
 	return len(content)
 }
 
+// Reset removes the lines appended for key and forgets the key, so the next
+// AppendStatic call with it renders fresh content. Other zones are kept.
+// Unknown keys are ignored. It is safe to call from any goroutine.
+func (sm *StaticManager) Reset(key string) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	zone, exists := sm.staticKeys[key]
+	if !exists {
+		return
+	}
+	delete(sm.staticKeys, key)
+	removed := zone.end - zone.start
+	sm.staticBuffer = append(sm.staticBuffer[:zone.start], sm.staticBuffer[zone.end:]...)
+	for k, z := range sm.staticKeys {
+		if z.start >= zone.end {
+			sm.staticKeys[k] = staticZone{start: z.start - removed, end: z.end - removed}
+		}
+	}
+}
+
+// Append adds lines to the static buffer without a key.
+// It is safe to call from any goroutine.
+func (sm *StaticManager) Append(lines ...string) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.staticBuffer = append(sm.staticBuffer, lines...)
+}
+
+// LineCount returns the number of lines accumulated across all static zones
+// and lines added with Append.
+func (sm *StaticManager) LineCount() int {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return len(sm.staticBuffer)
+}
+
+// Lines returns a copy of the accumulated lines, in order. Changing the
+// returned slice does not affect the manager.
+func (sm *StaticManager) Lines() []string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	return append([]string(nil), sm.staticBuffer...)
+}
+
 func (sm *StaticManager) RenderStatic() string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
 	return strings.Join(sm.staticBuffer, "\n")
 }
 
 func (sm *StaticManager) Clear() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
 	sm.staticBuffer = []string{}
-	sm.staticKeys = make(map[string]int)
+	sm.staticKeys = make(map[string]staticZone)
 }
@@ -1,6 +1,10 @@
 package runetui
 
-import "testing"
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
 
 func TestNewStaticManager_ReturnsNonNil(t *testing.T) {
 	sm := NewStaticManager()
@@ -112,3 +116,145 @@ func TestRenderStatic_WithSingleLine_ReturnsWithoutNewline(t *testing.T) {
 		t.Errorf("expected %q, got %q", expected, result)
 	}
 }
+
+func TestAppend_WithLines_AddsToBuffer(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("key1", []string{"line1"})
+	sm.Append("line2", "line3")
+	result := sm.RenderStatic()
+	expected := "line1\nline2\nline3"
+	if result != expected {
+		t.Errorf("expected %q, got %q", expected, result)
+	}
+}
+
+func TestAppend_CalledTwice_AccumulatesWithoutKey(t *testing.T) {
+	sm := NewStaticManager()
+	sm.Append("line1")
+	sm.Append("line1")
+	result := sm.RenderStatic()
+	expected := "line1\nline1"
+	if result != expected {
+		t.Errorf("expected %q, got %q", expected, result)
+	}
+}
+
+func TestAppendStatic_EmptyKey_WarnsOnce(t *testing.T) {
+	var buf bytes.Buffer
+	previous := warningOutput
+	warningOutput = &buf
+	defer func() { warningOutput = previous }()
+
+	sm := NewStaticManager()
+	sm.AppendStatic("", []string{"a"})
+	sm.AppendStatic("", []string{"b"})
+	sm.AppendStatic("logs", []string{"c"})
+
+	if strings.Count(buf.String(), "empty key") != 1 {
+		t.Errorf("expected a single warning, got %q", buf.String())
+	}
+}
+
+func TestReset_AfterAppend_AllowsReAppend(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("logs", []string{"old1", "old2"})
+
+	sm.Reset("logs")
+	count := sm.AppendStatic("logs", []string{"new1"})
+
+	if count != 1 {
+		t.Errorf("expected count 1 after reset, got %d", count)
+	}
+	if result := sm.RenderStatic(); result != "new1" {
+		t.Errorf("expected %q, got %q", "new1", result)
+	}
+}
+
+func TestReset_KeepsOtherZones(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("header", []string{"h1"})
+	sm.AppendStatic("logs", []string{"l1", "l2"})
+	sm.Append("printed")
+	sm.AppendStatic("footer", []string{"f1"})
+
+	sm.Reset("logs")
+	sm.Reset("footer")
+	sm.AppendStatic("footer", []string{"f2"})
+
+	if result := sm.RenderStatic(); result != "h1\nprinted\nf2" {
+		t.Errorf("expected %q, got %q", "h1\nprinted\nf2", result)
+	}
+}
+
+func TestReset_UnknownKey_IsNoOp(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("logs", []string{"line1"})
+
+	sm.Reset("missing")
+
+	if result := sm.RenderStatic(); result != "line1" {
+		t.Errorf("expected %q, got %q", "line1", result)
+	}
+	if count := sm.AppendStatic("logs", []string{"line2"}); count != 0 {
+		t.Errorf("expected existing key to stay rendered, got count %d", count)
+	}
+}
+
+func TestLineCount_FreshManager_ReturnsZero(t *testing.T) {
+	sm := NewStaticManager()
+
+	if count := sm.LineCount(); count != 0 {
+		t.Errorf("expected 0, got %d", count)
+	}
+}
+
+func TestLineCount_AcrossKeys_CountsAllLines(t *testing.T) {
+	sm := NewStaticManager()
+
+	sm.AppendStatic("key1", []string{"a", "b"})
+	if count := sm.LineCount(); count != 2 {
+		t.Errorf("expected 2 after first key, got %d", count)
+	}
+	sm.AppendStatic("key2", []string{"c"})
+	sm.Append("d")
+
+	if count := sm.LineCount(); count != 4 {
+		t.Errorf("expected 4, got %d", count)
+	}
+}
+
+func TestLineCount_AfterClear_ReturnsZero(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("key1", []string{"a", "b"})
+
+	sm.Clear()
+
+	if count := sm.LineCount(); count != 0 {
+		t.Errorf("expected 0 after Clear, got %d", count)
+	}
+}
+
+func TestLines_ReturnsAccumulatedLinesInOrder(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("key1", []string{"a", "b"})
+	sm.Append("c")
+
+	lines := sm.Lines()
+
+	if strings.Join(lines, ",") != "a,b,c" {
+		t.Errorf("expected [a b c], got %v", lines)
+	}
+}
+
+func TestLines_ReturnsCopy(t *testing.T) {
+	sm := NewStaticManager()
+	sm.AppendStatic("key1", []string{"a", "b"})
+
+	lines := sm.Lines()
+	lines[0] = "changed"
+	_ = append(lines[:1], "appended")
+
+	if result := sm.RenderStatic(); result != "a\nb" {
+		t.Errorf("expected manager content unchanged, got %q", result)
+	}
+}
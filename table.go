@@ -0,0 +1,273 @@
+package runetui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TableColumn sets the alignment and width limits of a table column.
+// A zero MinWidth or MaxWidth leaves that side unconstrained.
+type TableColumn struct {
+	Align    TextAlign
+	MinWidth int
+	MaxWidth int
+}
+
+// TableProps defines properties for the Table component.
+// ColumnWidths fixes the content width of each column; zero or missing
+// entries size the column to its widest cell. Columns adds per-column
+// alignment and width limits on top of that. AlternateBackground colors
+// every second data row.
+type TableProps struct {
+	Headers             []string
+	ColumnWidths        []int
+	Columns             []TableColumn
+	BorderStyle         BorderStyle
+	HeaderBold          bool
+	HeaderBackground    string
+	RowSeparator        bool
+	AlternateBackground string
+	Key                 string
+}
+
+func (TableProps) isProps() {}
+
+// table is the private implementation of the Table component.
+type table struct {
+	props TableProps
+	rows  [][]string
+}
+
+// Table creates a grid of rows with optional headers. Cells wider than their
+// column are truncated with "…", and columns shrink, widest first, when the
+// table is wider than its layout.
+//
+// Example:
+//
+//	Table(TableProps{
+//	    Headers:     []string{"PID", "Name", "CPU"},
+//	    Columns:     []TableColumn{{Align: TextAlignRight}, {MaxWidth: 20}, {Align: TextAlignRight}},
+//	    BorderStyle: BorderRounded,
+//	    HeaderBold:  true,
+//	},
+//	    []string{"1", "init", "0.1%"},
+//	    []string{"842", "postgres", "3.4%"},
+//	)
+func Table(props TableProps, rows ...[]string) Component {
+	return &table{props: props, rows: rows}
+}
+
+// columnCount returns the number of columns across the headers and rows.
+func (t *table) columnCount() int {
+	count := len(t.props.Headers)
+	for _, row := range t.rows {
+		count = max(count, len(row))
+	}
+	return count
+}
+
+// column returns the settings of column i.
+func (t *table) column(i int) TableColumn {
+	if i < len(t.props.Columns) {
+		return t.props.Columns[i]
+	}
+	return TableColumn{}
+}
+
+// columnWidths returns the content width of each column before fitting the
+// table to a layout.
+func (t *table) columnWidths() []int {
+	widths := make([]int, t.columnCount())
+	for i := range widths {
+		if i < len(t.props.ColumnWidths) && t.props.ColumnWidths[i] > 0 {
+			widths[i] = t.props.ColumnWidths[i]
+		} else {
+			widths[i] = lipgloss.Width(cellAt(t.props.Headers, i))
+			for _, row := range t.rows {
+				widths[i] = max(widths[i], lipgloss.Width(cellAt(row, i)))
+			}
+		}
+		column := t.column(i)
+		if column.MinWidth > 0 {
+			widths[i] = max(widths[i], column.MinWidth)
+		}
+		if column.MaxWidth > 0 {
+			widths[i] = min(widths[i], column.MaxWidth)
+		}
+	}
+	return widths
+}
+
+// fitWidths shrinks the widest columns, one column at a time, until the
+// table fits within width. Columns never shrink below one cell.
+func (t *table) fitWidths(widths []int, width int) []int {
+	for t.totalWidth(widths) > width {
+		widest := 0
+		for i := range widths {
+			if widths[i] > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 1 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths
+}
+
+// totalWidth returns the rendered width of a table with the given column
+// widths: bordered cells have one space of padding on each side and columns
+// are otherwise separated by two spaces.
+func (t *table) totalWidth(widths []int) int {
+	if len(widths) == 0 {
+		return 0
+	}
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	if t.props.BorderStyle == BorderNone {
+		return total + 2*(len(widths)-1)
+	}
+	return total + 3*len(widths) + 1
+}
+
+// cellAt returns row[i], or "" for missing cells.
+func cellAt(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// Render generates the header, rows and any borders and separators.
+func (t *table) Render(layout Layout) string {
+	widths := t.columnWidths()
+	if layout.Width > 0 {
+		widths = t.fitWidths(widths, layout.Width)
+	}
+	bordered := t.props.BorderStyle != BorderNone
+	border := lipglossBorder(t.props.BorderStyle)
+
+	rule := func(left, middle, right string) string {
+		if !bordered {
+			return strings.Repeat("─", t.totalWidth(widths))
+		}
+		segments := make([]string, len(widths))
+		for i, w := range widths {
+			segments[i] = strings.Repeat(border.Top, w+2)
+		}
+		return left + strings.Join(segments, middle) + right
+	}
+
+	var lines []string
+	if bordered {
+		lines = append(lines, rule(border.TopLeft, border.MiddleTop, border.TopRight))
+	}
+	if len(t.props.Headers) > 0 {
+		lines = append(lines, t.renderRow(t.props.Headers, widths, TextProps{
+			Bold:       t.props.HeaderBold,
+			Background: t.props.HeaderBackground,
+		}))
+		if bordered {
+			lines = append(lines, rule(border.MiddleLeft, border.Middle, border.MiddleRight))
+		}
+	}
+	for i, row := range t.rows {
+		if i > 0 && t.props.RowSeparator {
+			lines = append(lines, rule(border.MiddleLeft, border.Middle, border.MiddleRight))
+		}
+		style := TextProps{}
+		if i%2 == 1 {
+			style.Background = t.props.AlternateBackground
+		}
+		lines = append(lines, t.renderRow(row, widths, style))
+	}
+	if bordered {
+		lines = append(lines, rule(border.BottomLeft, border.MiddleBottom, border.BottomRight))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderRow aligns and truncates each cell to its column and joins them
+// with the column separators.
+func (t *table) renderRow(row []string, widths []int, style TextProps) string {
+	bordered := t.props.BorderStyle != BorderNone
+	cells := make([]string, len(widths))
+	for i, w := range widths {
+		content := alignCell(cellAt(row, i), w, t.column(i).Align)
+		if bordered {
+			content = " " + content + " "
+		}
+		cells[i] = Text(content, style).Render(Layout{})
+	}
+	if !bordered {
+		return strings.Join(cells, "  ")
+	}
+	left := lipglossBorder(t.props.BorderStyle).Left
+	return left + strings.Join(cells, left) + left
+}
+
+// alignCell truncates content to width with a trailing "…" and pads it
+// according to align. A cell narrower than 1 column is empty, and a 1-column
+// cell that does not fit shows only the "…".
+func alignCell(content string, width int, align TextAlign) string {
+	switch {
+	case width <= 0:
+		return ""
+	case width == 1 && lipgloss.Width(content) > 1:
+		return "…"
+	case lipgloss.Width(content) > width:
+		content = markOverflow(content, width, '…')
+	}
+	pad := max(0, width-lipgloss.Width(content))
+	switch align {
+	case TextAlignRight:
+		return strings.Repeat(" ", pad) + content
+	case TextAlignCenter:
+		return strings.Repeat(" ", pad/2) + content + strings.Repeat(" ", pad-pad/2)
+	default:
+		return content + strings.Repeat(" ", pad)
+	}
+}
+
+// Children returns an empty slice since tables have no children.
+func (t *table) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (t *table) Key() string {
+	return t.props.Key
+}
+
+func (t *table) label() string {
+	return "table"
+}
+
+// Measure returns the width of the table with columns sized to their content,
+// limited to the available width, and one line per header, row, border and
+// separator.
+func (t *table) Measure(availableWidth, availableHeight int) Size {
+	width := t.totalWidth(t.columnWidths())
+	if availableWidth > 0 {
+		width = min(width, availableWidth)
+	}
+
+	height := len(t.rows)
+	if len(t.props.Headers) > 0 {
+		height++
+	}
+	if t.props.RowSeparator && len(t.rows) > 1 {
+		height += len(t.rows) - 1
+	}
+	if t.props.BorderStyle != BorderNone {
+		height += 2
+		if len(t.props.Headers) > 0 {
+			height++
+		}
+	}
+	return Size{Width: width, Height: height}
+}
@@ -0,0 +1,145 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTable_Render_NoBorder_AlignsColumns(t *testing.T) {
+	c := Table(TableProps{Headers: []string{"PID", "Name"}},
+		[]string{"1", "init"},
+		[]string{"842", "postgres"},
+	)
+
+	expected := "PID  Name    \n" +
+		"1    init    \n" +
+		"842  postgres"
+	if output := StripANSI(c.Render(Layout{})); output != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, output)
+	}
+}
+
+func TestTable_Render_Border(t *testing.T) {
+	c := Table(TableProps{Headers: []string{"A", "B"}, BorderStyle: BorderSingle},
+		[]string{"1", "22"},
+	)
+
+	expected := strings.Join([]string{
+		"┌───┬────┐",
+		"│ A │ B  │",
+		"├───┼────┤",
+		"│ 1 │ 22 │",
+		"└───┴────┘",
+	}, "\n")
+	if output := StripANSI(c.Render(Layout{})); output != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, output)
+	}
+}
+
+func TestTable_Render_RowSeparator(t *testing.T) {
+	c := Table(TableProps{BorderStyle: BorderRounded, RowSeparator: true},
+		[]string{"a"},
+		[]string{"b"},
+	)
+
+	expected := strings.Join([]string{
+		"╭───╮",
+		"│ a │",
+		"├───┤",
+		"│ b │",
+		"╰───╯",
+	}, "\n")
+	if output := StripANSI(c.Render(Layout{})); output != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, output)
+	}
+}
+
+func TestTable_Render_ColumnSettings(t *testing.T) {
+	c := Table(TableProps{
+		ColumnWidths: []int{0, 5},
+		Columns:      []TableColumn{{Align: TextAlignRight, MinWidth: 4}, {Align: TextAlignCenter}},
+	},
+		[]string{"7", "ab"},
+		[]string{"42", "overflowing"},
+	)
+
+	expected := "   7   ab  \n" +
+		"  42  over…"
+	if output := StripANSI(c.Render(Layout{})); output != expected {
+		t.Errorf("expected\n%q\ngot\n%q", expected, output)
+	}
+}
+
+func TestTable_Render_ShrinksToLayoutWidth(t *testing.T) {
+	c := Table(TableProps{}, []string{"short", "a much longer cell"})
+
+	output := StripANSI(c.Render(Layout{Width: 16}))
+
+	if output != "short  a much l…" {
+		t.Errorf("expected the widest column truncated, got %q", output)
+	}
+}
+
+func TestTable_Render_NarrowLayout_KeepsRowsWithinWidth(t *testing.T) {
+	c := Table(TableProps{Headers: []string{"Name", "Status"}},
+		[]string{"postgres", "running"},
+	)
+
+	output := StripANSI(c.Render(Layout{Width: 4}))
+
+	expected := "…  …\n…  …"
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestAlignCell_NarrowWidths(t *testing.T) {
+	tests := []struct {
+		content  string
+		width    int
+		expected string
+	}{
+		{"abc", 1, "…"},
+		{"a", 1, "a"},
+		{"", 1, " "},
+		{"abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := alignCell(tt.content, tt.width, TextAlignLeft); got != tt.expected {
+			t.Errorf("alignCell(%q, %d): expected %q, got %q", tt.content, tt.width, tt.expected, got)
+		}
+	}
+}
+
+func TestTable_Render_HeaderAndZebraStyles(t *testing.T) {
+	c := Table(TableProps{
+		Headers:             []string{"H"},
+		HeaderBold:          true,
+		HeaderBackground:    "#112233",
+		AlternateBackground: "#445566",
+	}, []string{"a"}, []string{"b"}, []string{"c"})
+
+	lines := strings.Split(c.Render(Layout{}), "\n")
+
+	if !strings.Contains(lines[0], "1;") || !strings.Contains(lines[0], "48;2;17;34;51") {
+		t.Errorf("expected bold header with background, got %q", lines[0])
+	}
+	if strings.Contains(lines[1], "48;2") || !strings.Contains(lines[2], "48;2;68;85;102") || strings.Contains(lines[3], "48;2") {
+		t.Errorf("expected only the second row striped, got %q", lines[1:])
+	}
+}
+
+func TestTable_Measure(t *testing.T) {
+	c := Table(TableProps{Headers: []string{"A", "B"}, BorderStyle: BorderSingle, RowSeparator: true},
+		[]string{"1", "22"},
+		[]string{"3", "4", "extra"},
+	)
+
+	if size := c.Measure(80, 24); size != (Size{Width: 18, Height: 7}) {
+		t.Errorf("expected 18x7, got %+v", size)
+	}
+	if size := c.Measure(10, 24); size.Width != 10 {
+		t.Errorf("expected width limited to 10, got %+v", size)
+	}
+}
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/runetui/runetui"
+)
+
+// FuzzRender checks that layout and rendering never panic, whatever the box
+// properties and text content. Sizes are bounded to keep renders small.
+func FuzzRender(f *testing.F) {
+	f.Add(80, 24, 0, 0, 0, 0, 0, 0, "Hello")
+	f.Add(10, 5, 1, 1, 1, 1, 5, 2, "héllo wörld")
+	f.Add(0, 0, 2, 3, 2, 0, 0, 0, "")
+	f.Add(3, 1, -1, -2, 3, 1, -4, -1, "a\nmulti\nline")
+
+	f.Fuzz(func(t *testing.T, width, height, gap, padding, border, direction, fixedWidth, fixedHeight int, content string) {
+		rootFunc := func() runetui.Component {
+			props := runetui.BoxProps{
+				Direction: runetui.Direction(bounded(direction, 2)),
+				Gap:       bounded(gap, 5),
+				Padding:   runetui.SpacingAll(bounded(padding, 5)),
+				Border:    runetui.BorderStyle(bounded(border, 4)),
+			}
+			if fixedWidth != 0 {
+				props.Width = runetui.DimensionFixed(bounded(fixedWidth, 100))
+			}
+			if fixedHeight != 0 {
+				props.Height = runetui.DimensionFixed(bounded(fixedHeight, 50))
+			}
+			return runetui.Box(props,
+				runetui.Text(content),
+				runetui.Text(content, runetui.TextProps{Wrap: runetui.WrapWord}),
+				runetui.Text(content, runetui.TextProps{Wrap: runetui.WrapTruncate}),
+			)
+		}
+
+		output := RenderToString(rootFunc, bounded(width, 200), bounded(height, 100))
+
+		if len(output) > 1<<20 {
+			t.Errorf("expected bounded output, got %d bytes", len(output))
+		}
+	})
+}
+
+// bounded maps any int into [0, limit).
+func bounded(value, limit int) int {
+	value %= limit
+	if value < 0 {
+		value = -value
+	}
+	return value
+}
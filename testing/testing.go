@@ -36,17 +36,42 @@ package testing
 
 import (
 	"flag"
+	"fmt"
+	"io/fs"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/runetui/runetui"
 )
 
 var updateGolden = flag.Bool("update", false, "update golden files")
 
+// UpdateGoldenEnv is the environment variable that enables golden file updates
+// as an alternative to the -update flag, e.g. RUNETUI_UPDATE_GOLDEN=1 in CI.
+const UpdateGoldenEnv = "RUNETUI_UPDATE_GOLDEN"
+
+// shouldUpdateGolden reports whether golden files should be rewritten,
+// either because of the -update flag or the UpdateGoldenEnv variable.
+func shouldUpdateGolden() bool {
+	if *updateGolden {
+		return true
+	}
+	switch strings.ToLower(os.Getenv(UpdateGoldenEnv)) {
+	case "", "0", "false":
+		return false
+	}
+	return true
+}
+
 // RenderToString renders a component tree to a string without starting a terminal.
 // This is useful for testing components in non-interactive environments.
+// ANSI escape codes are preserved; it is equivalent to RenderWithANSI.
 //
 // The width and height parameters define the available space for rendering,
 // similar to terminal dimensions.
@@ -59,12 +84,87 @@ var updateGolden = flag.Bool("update", false, "update golden files")
 //	output := testing.RenderToString(rootFunc, 80, 24)
 //	fmt.Println(output) // "Hello, World!"
 func RenderToString(rootFunc func() runetui.Component, width, height int) string {
+	return RenderWithANSI(rootFunc, width, height)
+}
+
+// RenderWithANSI renders a component tree to a string, preserving ANSI escape
+// codes. Use it with assertions about styling such as runetui.AssertHasANSICodes.
+func RenderWithANSI(rootFunc func() runetui.Component, width, height int) string {
 	engine := runetui.NewLayoutEngine(width, height)
 	root := rootFunc()
 	tree := engine.CalculateLayout(root)
 	return renderTree(tree)
 }
 
+// RenderPlain renders a component tree to a string with all ANSI escape codes
+// removed. Use it to assert on visible text only.
+func RenderPlain(rootFunc func() runetui.Component, width, height int) string {
+	return runetui.StripANSI(RenderWithANSI(rootFunc, width, height))
+}
+
+// RenderToLines renders a component tree and splits the output into lines,
+// for assertions about individual rows. It returns nil when nothing is
+// rendered. Lines keep their ANSI escape codes; AssertLine ignores them.
+//
+// Example:
+//
+//	lines := testing.RenderToLines(rootFunc, 40, 10)
+//	testing.AssertLineCount(t, lines, 3)
+//	testing.AssertLine(t, lines, 1, "│Hello│")
+func RenderToLines(rootFunc func() runetui.Component, width, height int) []string {
+	output := RenderToString(rootFunc, width, height)
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
+
+// AssertLine verifies that the line at index, with ANSI escape codes removed,
+// equals expected. On failure the message lists all lines with their indexes.
+func AssertLine(t testing.TB, lines []string, index int, expected string) {
+	t.Helper()
+	if index < 0 || index >= len(lines) {
+		t.Errorf("line %d out of range: got %d lines\n%s", index, len(lines), numberedLines(lines))
+		return
+	}
+	if got := runetui.StripANSI(lines[index]); got != expected {
+		t.Errorf("line %d: expected %q, got %q\n%s", index, expected, got, numberedLines(lines))
+	}
+}
+
+// AssertLineCount verifies that lines has exactly expected entries. On failure
+// the message lists all lines with their indexes.
+func AssertLineCount(t testing.TB, lines []string, expected int) {
+	t.Helper()
+	if len(lines) != expected {
+		t.Errorf("expected %d lines, got %d\n%s", expected, len(lines), numberedLines(lines))
+	}
+}
+
+// AssertContainsAt verifies that text appears in the rendered output starting
+// at column x of line y, ignoring ANSI codes. It is runetui.AssertContainsAt,
+// repeated here so tests using this package need only one import.
+//
+// Example:
+//
+//	output := testing.RenderToString(rootFunc, 20, 5)
+//	testing.AssertContainsAt(t, output, 0, 0, "┌")
+//	testing.AssertContainsAt(t, output, 1, 1, "Hello")
+func AssertContainsAt(t testing.TB, output string, x, y int, text string) {
+	t.Helper()
+	runetui.AssertContainsAt(t, output, x, y, text)
+}
+
+// numberedLines formats lines without ANSI codes, one per row, prefixed with
+// their index.
+func numberedLines(lines []string) string {
+	var sb strings.Builder
+	for i, line := range lines {
+		fmt.Fprintf(&sb, "%3d| %s\n", i, runetui.StripANSI(line))
+	}
+	return sb.String()
+}
+
 // renderTree recursively renders a layout tree to a string.
 func renderTree(tree *runetui.LayoutTree) string {
 	if tree == nil {
@@ -72,6 +172,10 @@ func renderTree(tree *runetui.LayoutTree) string {
 	}
 
 	rendered := tree.Component.Render(tree.Layout)
+	if len(tree.Component.Children()) > 0 {
+		// Containers render their own children.
+		return rendered
+	}
 
 	for _, child := range tree.Children {
 		childOutput := renderTree(child)
@@ -85,8 +189,10 @@ func renderTree(tree *runetui.LayoutTree) string {
 
 // AssertSnapshot compares the output string against a golden file.
 // If the golden file doesn't exist, it creates a new golden file with the output.
-// If the -update flag is set, it updates existing golden files with the new output.
-// If the content differs from the golden file, the test fails with a diff.
+// If the -update flag or the RUNETUI_UPDATE_GOLDEN environment variable is set,
+// it updates existing golden files with the new output.
+// If the content differs from the golden file, the test fails with a unified
+// diff; set RUNETUI_DIFF_CONTEXT to change the number of context lines.
 //
 // Golden files are stored in testdata/<name>.golden relative to the test file.
 //
@@ -98,12 +204,13 @@ func renderTree(tree *runetui.LayoutTree) string {
 // To update golden files when the output intentionally changes:
 //
 //	go test -update
+//	RUNETUI_UPDATE_GOLDEN=1 go test ./...
 func AssertSnapshot(t testing.TB, name string, output string) {
 	t.Helper()
 
 	goldenFile := filepath.Join("testdata", name+".golden")
 
-	if *updateGolden {
+	if shouldUpdateGolden() {
 		writeGoldenFile(t, goldenFile, output)
 		return
 	}
@@ -118,21 +225,262 @@ func AssertSnapshot(t testing.TB, name string, output string) {
 	}
 
 	if string(expected) != output {
-		t.Errorf("snapshot mismatch for %s:\nexpected:\n%s\n\ngot:\n%s\n\nrun with -update to update golden files", name, expected, output)
+		t.Errorf("snapshot mismatch for %s:\n%s\nrun with -update to update golden files", name, diff(string(expected), output))
+	}
+}
+
+// DiffContextEnv is the environment variable that sets how many unchanged
+// lines snapshot diffs show around each change, e.g. RUNETUI_DIFF_CONTEXT=10.
+// The default is 3.
+const DiffContextEnv = "RUNETUI_DIFF_CONTEXT"
+
+// diffContext returns the number of context lines for snapshot diffs.
+func diffContext() int {
+	if n, err := strconv.Atoi(os.Getenv(DiffContextEnv)); err == nil && n >= 0 {
+		return n
+	}
+	return 3
+}
+
+// diffOp is one line of a line diff: ' ' for a line in both inputs, '-' for
+// a line only in expected and '+' for a line only in got.
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// diff returns a unified diff from expected to got, comparing whole lines and
+// showing diffContext unchanged lines around each change.
+func diff(expected, got string) string {
+	ops := diffLines(strings.Split(expected, "\n"), strings.Split(got, "\n"))
+	context := diffContext()
+
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ got\n")
+
+	// oldLine and newLine count the lines of expected and got before each op.
+	oldLine := make([]int, len(ops)+1)
+	newLine := make([]int, len(ops)+1)
+	for i, op := range ops {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if op.kind != '+' {
+			oldLine[i+1]++
+		}
+		if op.kind != '-' {
+			newLine[i+1]++
+		}
 	}
+
+	for start := 0; start < len(ops); {
+		change := start
+		for change < len(ops) && ops[change].kind == ' ' {
+			change++
+		}
+		if change == len(ops) {
+			break
+		}
+		from := max(start, change-context)
+		// Extend the hunk while the next change is within reach of its context.
+		to, unchanged := change, 0
+		for i := change; i < len(ops) && unchanged <= 2*context; i++ {
+			if ops[i].kind == ' ' {
+				unchanged++
+				continue
+			}
+			unchanged = 0
+			to = i + 1
+		}
+		to = min(len(ops), to+context)
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", oldLine[from]+1, oldLine[to]-oldLine[from], newLine[from]+1, newLine[to]-newLine[from])
+		for _, op := range ops[from:to] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+		start = to
+	}
+	return sb.String()
+}
+
+// diffLines returns the edit script turning a into b along a longest common
+// subsequence of lines.
+func diffLines(a, b []string) []diffOp {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
 }
 
 func writeGoldenFile(t testing.TB, path string, content string) {
-	if err := os.MkdirAll("testdata", 0755); err != nil {
-		t.Fatalf("failed to create testdata directory: %v", err)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create golden file directory: %v", err)
 	}
 	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
 		t.Fatalf("failed to write golden file: %v", err)
 	}
 }
 
+// AssertGoldenDir compares each output against dir/<name>.golden, creating or
+// updating golden files like AssertSnapshot. All mismatches are collected and
+// reported in a single failure, in name order, so one run shows every variant
+// that changed.
+//
+// Example:
+//
+//	testing.AssertGoldenDir(t, "testdata/progress", map[string]string{
+//	    "width_10": testing.RenderToString(bar(10), 10, 1),
+//	    "width_40": testing.RenderToString(bar(40), 40, 1),
+//	})
+func AssertGoldenDir(t testing.TB, dir string, outputs map[string]string) {
+	t.Helper()
+
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var mismatches []string
+	for _, name := range names {
+		output := outputs[name]
+		goldenFile := filepath.Join(dir, name+".golden")
+
+		if shouldUpdateGolden() {
+			writeGoldenFile(t, goldenFile, output)
+			continue
+		}
+
+		expected, err := os.ReadFile(goldenFile)
+		if os.IsNotExist(err) {
+			writeGoldenFile(t, goldenFile, output)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("failed to read golden file: %v", err)
+		}
+
+		if string(expected) != output {
+			mismatches = append(mismatches, fmt.Sprintf("%s:\n%s", goldenFile, diff(string(expected), output)))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		t.Errorf("%d of %d snapshots in %s mismatch:\n\n%s\n\nrun with -update to update golden files",
+			len(mismatches), len(outputs), dir, strings.Join(mismatches, "\n\n"))
+	}
+}
+
+// UpdateAllGolden regenerates every .golden file under dir in one pass by
+// re-running the tests of the package that owns dir with RUNETUI_UPDATE_GOLDEN
+// set. dir is usually "testdata", and its parent directory is the package run.
+// Golden files that changed are logged; files no test writes are left as is.
+//
+// The call is a no-op inside the re-run itself, so it can live in an ordinary
+// test that is gated behind a flag or environment variable:
+//
+//	func TestUpdateGolden(t *testing.T) {
+//	    if os.Getenv("REGEN") == "" {
+//	        t.Skip("set REGEN=1 to regenerate golden files")
+//	    }
+//	    testing.UpdateAllGolden(t, "testdata")
+//	}
+func UpdateAllGolden(t testing.TB, dir string) {
+	t.Helper()
+
+	if shouldUpdateGolden() {
+		return
+	}
+
+	before, err := readGoldenFiles(dir)
+	if err != nil {
+		t.Fatalf("failed to read golden files: %v", err)
+	}
+
+	cmd := exec.Command("go", "test", "-count=1", ".")
+	cmd.Dir = filepath.Dir(filepath.Clean(dir))
+	cmd.Env = append(os.Environ(), UpdateGoldenEnv+"=1")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to regenerate golden files: %v\n%s", err, output)
+	}
+
+	after, err := readGoldenFiles(dir)
+	if err != nil {
+		t.Fatalf("failed to read golden files: %v", err)
+	}
+	var updated []string
+	for path, content := range after {
+		if previous, ok := before[path]; !ok || previous != content {
+			updated = append(updated, path)
+		}
+	}
+	sort.Strings(updated)
+	for _, path := range updated {
+		t.Logf("updated %s", path)
+	}
+}
+
+// readGoldenFiles returns the contents of all .golden files under dir keyed by path.
+func readGoldenFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".golden" {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[path] = string(content)
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+	return files, err
+}
+
 // TestApp is a test wrapper that allows simulating user interactions
-// with RuneTUI components without starting a terminal.
+// with RuneTUI components without starting a terminal. It drives the model
+// returned by runetui.App.Model, so messages are handled and frames are
+// rendered as in a running app.
 //
 // Example:
 //
@@ -145,38 +493,258 @@ func writeGoldenFile(t testing.TB, path string, content string) {
 //	view := app.View()
 //	fmt.Println(view)
 type TestApp struct {
-	rootFunc func() runetui.Component
-	width    int
-	height   int
+	app   *runetui.App
+	model tea.Model
 }
 
-// NewTestApp creates a new TestApp for testing components.
-// The default dimensions are 80x24 (standard terminal size).
-func NewTestApp(rootFunc func() runetui.Component) *TestApp {
-	return &TestApp{
-		rootFunc: rootFunc,
-		width:    80,
-		height:   24,
+// NewTestApp creates a new TestApp for testing components and runs the app's
+// init command. The default dimensions are 80x24 (standard terminal size).
+// Options are applied as for runetui.New, so theme, no-color, shortcuts,
+// initial model and fullscreen behave as in the real App; use
+// runetui.WithUpdate to route simulated input through the application's
+// update function. The alternate screen is off unless opts include
+// runetui.WithAltScreen, so frames match an inline app by default.
+func NewTestApp(rootFunc func() runetui.Component, opts ...runetui.AppOption) *TestApp {
+	opts = append([]runetui.AppOption{runetui.WithSize(80, 24), runetui.WithNoAltScreen()}, opts...)
+	app := runetui.New(rootFunc, opts...)
+	testApp := &TestApp{
+		app:   app,
+		model: app.Model(),
 	}
+	testApp.run(testApp.model.Init())
+	return testApp
 }
 
-// Resize simulates a terminal resize event.
+// Resize simulates a terminal resize event. The update function receives the
+// tea.WindowSizeMsg, as in a running app.
 func (a *TestApp) Resize(width, height int) {
-	a.width = width
-	a.height = height
+	a.dispatch(tea.WindowSizeMsg{Width: width, Height: height})
 }
 
-// View returns the current rendered view of the component tree.
+// View returns the current frame: the static zone followed by the rendered
+// component tree.
 func (a *TestApp) View() string {
-	return RenderToString(a.rootFunc, a.width, a.height)
+	return a.model.View()
 }
 
-// SendKey simulates a keyboard input event.
-// Note: This is a placeholder for future stateful component support.
-// Currently, RuneTUI components are stateless, so this method stores the key
-// but doesn't trigger any updates. When state management is added, this will
-// trigger component updates and re-renders.
+// SendKey simulates pressing the key named key, using the names returned by
+// tea.Key.String such as "enter", "ctrl+c", "alt+x" or "a". Names that are not
+// special keys are sent as the runes they contain.
+//
+// Example:
+//
+//	app.SendKey("tab")
+//	app.SendKey("ctrl+s")
 func (a *TestApp) SendKey(key string) {
-	// Placeholder for future state management
-	// Will be implemented when components support state
+	a.PressKey(parseKey(key))
+}
+
+// keyTypes maps the names of special keys to their types.
+var keyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for k := tea.KeyType(-128); k <= 127; k++ {
+		if name := k.String(); name != "" && k != tea.KeyRunes {
+			types[name] = k
+		}
+	}
+	return types
+}()
+
+// parseKey converts a key name such as "alt+enter" to a tea.Key.
+func parseKey(name string) tea.Key {
+	var key tea.Key
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && rest != "" {
+		key.Alt = true
+		name = rest
+	}
+	if t, ok := keyTypes[name]; ok {
+		key.Type = t
+		if t == tea.KeySpace {
+			key.Runes = []rune{' '}
+		}
+		return key
+	}
+	key.Type = tea.KeyRunes
+	key.Runes = []rune(name)
+	return key
+}
+
+// Type simulates typing s one character at a time. Each rune is sent to the
+// update function as a tea.KeyRunes message, in order.
+//
+// Example:
+//
+//	app := testing.NewTestApp(rootFunc, runetui.WithUpdate(update))
+//	app.Type("Alice")
+//	runetui.AssertContainsText(t, app.View(), "Alice")
+func (a *TestApp) Type(s string) {
+	for _, r := range s {
+		a.dispatch(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+// PressKey simulates pressing key and sends the corresponding tea.KeyMsg to
+// the update function. Use it for keys that are awkward to describe as
+// strings, such as function keys or Alt combinations.
+//
+// Example:
+//
+//	app.PressKey(tea.Key{Type: tea.KeyF5})
+//	app.PressKey(tea.Key{Type: tea.KeyRunes, Runes: []rune{'x'}, Alt: true})
+func (a *TestApp) PressKey(key tea.Key) {
+	a.dispatch(tea.KeyMsg(key))
+}
+
+// SendMessage sends msg to the update function, mirroring
+// runetui.App.SendMessage. There is no running program in tests, so the
+// message is delivered synchronously and View reflects it immediately.
+//
+// Example:
+//
+//	app.SendMessage(dataLoadedMsg{items: items})
+//	runetui.AssertContainsText(t, app.View(), "3 items")
+func (a *TestApp) SendMessage(msg tea.Msg) {
+	a.dispatch(msg)
+}
+
+// maxCommands is the number of commands a TestApp runs after each message,
+// including the commands those commands' messages return. It stops an update
+// that keeps returning commands from hanging the test.
+const maxCommands = 100
+
+// dispatch sends msg to the app's model and runs the command it returns.
+func (a *TestApp) dispatch(msg tea.Msg) {
+	var cmd tea.Cmd
+	a.model, cmd = a.model.Update(msg)
+	a.run(cmd)
+}
+
+// run executes cmd and feeds its message back to the model, then does the
+// same for the commands that returns, up to maxCommands in all. Commands run
+// synchronously, so a tea.Tick waits for its duration. Batches are run in
+// order; tea.Quit is ignored because there is no program to stop.
+func (a *TestApp) run(cmd tea.Cmd) {
+	pending := []tea.Cmd{cmd}
+	for ran := 0; len(pending) > 0 && ran < maxCommands; ran++ {
+		cmd, pending = pending[0], pending[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case nil, tea.QuitMsg:
+		case tea.BatchMsg:
+			pending = append(pending, msg...)
+		default:
+			var next tea.Cmd
+			a.model, next = a.model.Update(msg)
+			pending = append(pending, next)
+		}
+	}
+}
+
+// AssertLayout_Explain verifies that the component with the given key is laid
+// out at the expected position and size. On failure the error message includes
+// the full LayoutEngine.Explain report to show why the layout differs.
+//
+// Example:
+//
+//	testing.AssertLayout_Explain(t, rootFunc, 80, 24, "sidebar",
+//	    runetui.Layout{X: 0, Y: 0, Width: 20, Height: 24})
+func AssertLayout_Explain(t testing.TB, rootFunc func() runetui.Component, width, height int, key string, expected runetui.Layout) {
+	t.Helper()
+
+	engine := runetui.NewLayoutEngine(width, height)
+	node := engine.CalculateLayout(rootFunc()).Find(key)
+
+	if node == nil {
+		t.Errorf("no component with key %q\nlayout:\n%s", key, engine.Explain(rootFunc()))
+		return
+	}
+	if node.Layout != expected {
+		t.Errorf("layout mismatch for %q: expected %+v, got %+v\nlayout:\n%s", key, expected, node.Layout, engine.Explain(rootFunc()))
+	}
+}
+
+// AssertNoOverflow verifies that no node in the layout tree extends beyond the
+// right or bottom edge of its parent. All violations are reported, each with
+// the keys and layouts of the child and parent involved.
+//
+// Example:
+//
+//	tree := runetui.NewLayoutEngine(80, 24).CalculateLayout(rootFunc())
+//	testing.AssertNoOverflow(t, tree)
+func AssertNoOverflow(t testing.TB, tree *runetui.LayoutTree) {
+	t.Helper()
+	for _, violation := range overflowViolations(tree) {
+		t.Errorf("%s", violation)
+	}
+}
+
+// overflowViolations collects a message for each child that overflows its parent.
+func overflowViolations(parent *runetui.LayoutTree) []string {
+	if parent == nil {
+		return nil
+	}
+
+	var violations []string
+	p := parent.Layout
+	for _, child := range parent.Children {
+		c := child.Layout
+		if c.X+c.Width > p.X+p.Width || c.Y+c.Height > p.Y+p.Height {
+			violations = append(violations, fmt.Sprintf("%s %+v overflows parent %s %+v",
+				nodeLabel(child), c, nodeLabel(parent), p))
+		}
+		violations = append(violations, overflowViolations(child)...)
+	}
+	return violations
+}
+
+// nodeLabel identifies a node by key, falling back to the component type.
+func nodeLabel(tree *runetui.LayoutTree) string {
+	if key := tree.Component.Key(); key != "" {
+		return fmt.Sprintf("%q", key)
+	}
+	return fmt.Sprintf("%T", tree.Component)
+}
+
+// AssertNoOverlap verifies that no two siblings in the layout tree have
+// intersecting rectangles. All violations are reported, each with the keys and
+// layouts of both siblings involved. Empty (zero-area) nodes never overlap.
+//
+// Example:
+//
+//	tree := runetui.NewLayoutEngine(80, 24).CalculateLayout(rootFunc())
+//	testing.AssertNoOverlap(t, tree)
+func AssertNoOverlap(t testing.TB, tree *runetui.LayoutTree) {
+	t.Helper()
+	for _, violation := range overlapViolations(tree) {
+		t.Errorf("%s", violation)
+	}
+}
+
+// overlapViolations collects a message for each pair of overlapping siblings.
+func overlapViolations(parent *runetui.LayoutTree) []string {
+	if parent == nil {
+		return nil
+	}
+
+	var violations []string
+	for i, a := range parent.Children {
+		for _, b := range parent.Children[i+1:] {
+			if intersects(a.Layout, b.Layout) {
+				violations = append(violations, fmt.Sprintf("%s %+v overlaps sibling %s %+v",
+					nodeLabel(a), a.Layout, nodeLabel(b), b.Layout))
+			}
+		}
+	}
+	for _, child := range parent.Children {
+		violations = append(violations, overlapViolations(child)...)
+	}
+	return violations
+}
+
+// intersects reports whether two layouts share at least one cell.
+func intersects(a, b runetui.Layout) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width &&
+		a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
 }
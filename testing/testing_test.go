@@ -1,8 +1,13 @@
 package testing
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/runetui/runetui"
 )
 
@@ -23,6 +28,97 @@ func TestRenderToString_WithSimpleText_RendersContent(t *testing.T) {
 	}
 }
 
+func TestRenderWithANSI_StyledText_PreservesANSICodes(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.Text("Hello", runetui.TextProps{Bold: true})
+	}
+
+	output := RenderWithANSI(rootFunc, 80, 24)
+
+	runetui.AssertHasANSICodes(t, output)
+	runetui.AssertContainsText(t, output, "Hello")
+}
+
+func TestRenderPlain_StyledText_StripsANSICodes(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.Text("Hello", runetui.TextProps{Bold: true})
+	}
+
+	output := RenderPlain(rootFunc, 80, 24)
+
+	if output != "Hello" {
+		t.Errorf("expected %q, got %q", "Hello", output)
+	}
+}
+
+func TestRenderPlain_JustifiedRow_PlacesChildrenLikeLayout(t *testing.T) {
+	cases := []struct {
+		justify  runetui.Justify
+		expected string
+	}{
+		{runetui.JustifyCenter, "        ABCD"},
+		{runetui.JustifyEnd, "                ABCD"},
+		{runetui.JustifySpaceBetween, "AB                CD"},
+		{runetui.JustifySpaceEvenly, "     AB     CD"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.justify.String(), func(t *testing.T) {
+			rootFunc := func() runetui.Component {
+				return runetui.Box(runetui.BoxProps{Direction: runetui.Row, Width: runetui.DimensionFixed(20), JustifyContent: tc.justify},
+					runetui.Text("AB"), runetui.Text("CD"))
+			}
+
+			output := strings.TrimRight(RenderPlain(rootFunc, 80, 24), " ")
+
+			if output != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, output)
+			}
+		})
+	}
+}
+
+func TestRenderPlain_JustifiedColumn_PlacesChildrenLikeLayout(t *testing.T) {
+	cases := []struct {
+		justify  runetui.Justify
+		expected []string
+	}{
+		{runetui.JustifyCenter, []string{"", "", "A", "B"}},
+		{runetui.JustifyEnd, []string{"", "", "", "", "", "A", "B"}},
+		{runetui.JustifySpaceBetween, []string{"A", "", "", "", "", "", "B"}},
+		{runetui.JustifySpaceEvenly, []string{"", "A", "", "B"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.justify.String(), func(t *testing.T) {
+			rootFunc := func() runetui.Component {
+				return runetui.Box(runetui.BoxProps{Height: runetui.DimensionFixed(7), JustifyContent: tc.justify},
+					runetui.Text("A"), runetui.Text("B"))
+			}
+
+			lines := strings.Split(RenderPlain(rootFunc, 80, 24), "\n")
+			for i := range lines {
+				lines[i] = strings.TrimRight(lines[i], " ")
+			}
+			for len(lines) > 0 && lines[len(lines)-1] == "" {
+				lines = lines[:len(lines)-1]
+			}
+
+			if strings.Join(lines, "|") != strings.Join(tc.expected, "|") {
+				t.Errorf("expected lines %q, got %q", tc.expected, lines)
+			}
+		})
+	}
+}
+
+func TestRenderToString_MatchesRenderWithANSI(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.Text("Hello", runetui.TextProps{Color: "#FF0000"})
+	}
+
+	if RenderToString(rootFunc, 80, 24) != RenderWithANSI(rootFunc, 80, 24) {
+		t.Error("expected RenderToString to preserve ANSI codes like RenderWithANSI")
+	}
+}
+
 // Test 2: RenderToString renders a box with children
 func TestRenderToString_WithBoxAndChildren_RendersAllComponents(t *testing.T) {
 	rootFunc := func() runetui.Component {
@@ -105,13 +201,63 @@ func TestTestApp_SendKey_SimulatesKeyPress(t *testing.T) {
 	app := NewTestApp(rootFunc)
 	app.SendKey("enter")
 
-	// For now, just verify the method exists and can be called
+	// Without an update function the key changes nothing
 	view := app.View()
 	if view == "" {
 		t.Error("expected non-empty view after SendKey")
 	}
 }
 
+// Test 7b: NewTestApp applies the same options as runetui.New
+func TestNewTestApp_AppliesAppOptions(t *testing.T) {
+	theme := runetui.DefaultTheme()
+	theme.Primary = "#123456"
+	rootFunc := func() runetui.Component {
+		return runetui.Text("primary "+runetui.CurrentTheme().Primary, runetui.TextProps{Color: "#FF0000"})
+	}
+
+	app := NewTestApp(rootFunc,
+		runetui.WithTheme(theme),
+		runetui.WithNoColor(),
+		runetui.WithShortcuts(runetui.Shortcut{Key: "q", Description: "quit"}),
+	)
+	view := app.View()
+
+	runetui.AssertContainsText(t, view, "primary #123456")
+	runetui.AssertContainsText(t, view, "quit")
+	if view != runetui.StripANSI(view) {
+		t.Errorf("expected WithNoColor to remove styling, got %q", view)
+	}
+}
+
+// Test 7b2: the alternate screen is off unless requested
+func TestNewTestApp_AltScreen_OffByDefault(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.Box(runetui.BoxProps{Cursor: runetui.CursorPosition{Col: 2, Visible: true}}, runetui.Text("abc"))
+	}
+
+	if view := NewTestApp(rootFunc).View(); view != "abc" {
+		t.Errorf("expected an inline frame without cursor placement, got %q", view)
+	}
+	if view := NewTestApp(rootFunc, runetui.WithAltScreen()).View(); !strings.HasSuffix(view, "\x1b[1;3H") {
+		t.Errorf("expected WithAltScreen to place the cursor, got %q", view)
+	}
+}
+
+// Test 7c: Resize changes the size the tree is laid out in
+func TestTestApp_Resize_LaysOutAtNewWidth(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.HStack(runetui.Text("a"), runetui.FlexSpacer(), runetui.Text("b"))
+	}
+
+	app := NewTestApp(rootFunc)
+	app.Resize(10, 5)
+
+	if got := runetui.StripANSI(app.View()); got != "a        b" {
+		t.Errorf("expected the row to span 10 columns, got %q", got)
+	}
+}
+
 // Test 8: RenderToString with zero dimensions
 func TestRenderToString_ZeroDimensions_HandlesGracefully(t *testing.T) {
 	rootFunc := func() runetui.Component {
@@ -210,6 +356,165 @@ func TestTestApp_SendKey_CanBeCalledMultipleTimes(t *testing.T) {
 	}
 }
 
+// Test 13b: Type sends one rune message per character to the update function
+func TestTestApp_Type_SendsEachRuneToUpdate(t *testing.T) {
+	var typed []rune
+	update := func(msg tea.Msg) tea.Cmd {
+		if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyRunes {
+			typed = append(typed, key.Runes...)
+		}
+		return nil
+	}
+	rootFunc := func() runetui.Component {
+		return runetui.Text(string(typed))
+	}
+
+	app := NewTestApp(rootFunc, runetui.WithUpdate(update))
+	app.Type("héllo")
+
+	if string(typed) != "héllo" {
+		t.Errorf("expected runes %q, got %q", "héllo", string(typed))
+	}
+	runetui.AssertContainsText(t, app.View(), "héllo")
+}
+
+// Test 13c: Type without an update function is a no-op
+func TestTestApp_Type_WithoutUpdate_DoesNotPanic(t *testing.T) {
+	app := NewTestApp(func() runetui.Component { return runetui.Text("Test") })
+
+	app.Type("abc")
+
+	runetui.AssertContainsText(t, app.View(), "Test")
+}
+
+// Test 13d: PressKey sends the structured key to the update function
+func TestTestApp_PressKey_SendsKeyMsgToUpdate(t *testing.T) {
+	var pressed []string
+	update := func(msg tea.Msg) tea.Cmd {
+		if key, ok := msg.(tea.KeyMsg); ok {
+			pressed = append(pressed, key.String())
+		}
+		return nil
+	}
+
+	app := NewTestApp(func() runetui.Component { return runetui.Text("Test") }, runetui.WithUpdate(update))
+	app.PressKey(tea.Key{Type: tea.KeyF5})
+	app.PressKey(tea.Key{Type: tea.KeyCtrlA, Alt: true})
+	app.PressKey(tea.Key{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	expected := []string{"f5", "alt+ctrl+a", "x"}
+	if fmt.Sprint(pressed) != fmt.Sprint(expected) {
+		t.Errorf("expected keys %v, got %v", expected, pressed)
+	}
+}
+
+// Test 13d2: SendKey sends the key with the given name
+func TestTestApp_SendKey_SendsNamedKeys(t *testing.T) {
+	var pressed []tea.KeyMsg
+	update := func(msg tea.Msg) tea.Cmd {
+		if key, ok := msg.(tea.KeyMsg); ok {
+			pressed = append(pressed, key)
+		}
+		return nil
+	}
+
+	app := NewTestApp(func() runetui.Component { return runetui.Text("Test") }, runetui.WithUpdate(update))
+	for _, key := range []string{"enter", "shift+tab", "ctrl+s", "alt+x", " ", "é"} {
+		app.SendKey(key)
+	}
+
+	expected := []tea.KeyType{tea.KeyEnter, tea.KeyShiftTab, tea.KeyCtrlS, tea.KeyRunes, tea.KeySpace, tea.KeyRunes}
+	if len(pressed) != len(expected) {
+		t.Fatalf("expected %d keys, got %d", len(expected), len(pressed))
+	}
+	for i, key := range pressed {
+		if key.Type != expected[i] {
+			t.Errorf("key %d: expected type %v, got %v (%q)", i, expected[i], key.Type, key.String())
+		}
+	}
+	if !pressed[3].Alt || string(pressed[3].Runes) != "x" {
+		t.Errorf("expected alt+x, got %q", pressed[3].String())
+	}
+	if string(pressed[5].Runes) != "é" {
+		t.Errorf("expected the rune é, got %q", pressed[5].String())
+	}
+}
+
+// Test 13d3: messages from returned commands reach the update function
+func TestTestApp_SendMessage_RunsReturnedCommands(t *testing.T) {
+	type loadMsg struct{}
+	type loadedMsg struct{ items int }
+	status := "Idle"
+	update := func(msg tea.Msg) tea.Cmd {
+		switch msg := msg.(type) {
+		case loadMsg:
+			status = "Loading..."
+			return tea.Batch(nil, func() tea.Msg { return loadedMsg{items: 3} })
+		case loadedMsg:
+			status = fmt.Sprintf("%d items", msg.items)
+		}
+		return nil
+	}
+
+	app := NewTestApp(func() runetui.Component { return runetui.Text(status) }, runetui.WithUpdate(update))
+	app.SendMessage(loadMsg{})
+
+	runetui.AssertContainsText(t, app.View(), "3 items")
+}
+
+// Test 13d4: init commands run when the test app is created
+func TestNewTestApp_RunsInitCommand(t *testing.T) {
+	type readyMsg struct{}
+	status := "starting"
+	app := NewTestApp(func() runetui.Component { return runetui.Text(status) },
+		runetui.WithInit(func() tea.Cmd { return func() tea.Msg { return readyMsg{} } }),
+		runetui.WithUpdate(func(msg tea.Msg) tea.Cmd {
+			if _, ok := msg.(readyMsg); ok {
+				status = "ready"
+			}
+			return nil
+		}))
+
+	runetui.AssertContainsText(t, app.View(), "ready")
+}
+
+// Test 13d5: a command chain that never ends is cut off
+func TestTestApp_EndlessCommands_AreBounded(t *testing.T) {
+	type tickMsg struct{}
+	updates := 0
+	update := func(msg tea.Msg) tea.Cmd {
+		updates++
+		return func() tea.Msg { return tickMsg{} }
+	}
+
+	app := NewTestApp(func() runetui.Component { return runetui.Text("Test") }, runetui.WithUpdate(update))
+	updates = 0
+	app.SendMessage(tickMsg{})
+
+	if updates != maxCommands+1 {
+		t.Errorf("expected the message and %d follow-ups, got %d updates", maxCommands, updates)
+	}
+}
+
+// Test 13e: SendMessage delivers custom messages to the update function
+func TestTestApp_SendMessage_UpdatesView(t *testing.T) {
+	type loadedMsg struct{ items int }
+	status := "Loading..."
+	update := func(msg tea.Msg) tea.Cmd {
+		if m, ok := msg.(loadedMsg); ok {
+			status = fmt.Sprintf("%d items", m.items)
+		}
+		return nil
+	}
+
+	app := NewTestApp(func() runetui.Component { return runetui.Text(status) }, runetui.WithUpdate(update))
+	runetui.AssertContainsText(t, app.View(), "Loading...")
+
+	app.SendMessage(loadedMsg{items: 3})
+
+	runetui.AssertContainsText(t, app.View(), "3 items")
+}
+
 // Test 14: AssertSnapshot with update flag
 func TestAssertSnapshot_WithUpdateFlag_CreatesFile(t *testing.T) {
 	// This test verifies that AssertSnapshot works with the update workflow
@@ -253,3 +558,435 @@ func TestRenderTree_WithChildren_CombinesAllOutput(t *testing.T) {
 		t.Errorf("expected combined output from all children, got %q", output)
 	}
 }
+
+// recordingTB captures assertion failures so failing paths can be tested.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func explainRoot() runetui.Component {
+	return runetui.Box(
+		runetui.BoxProps{Key: "panel", Border: runetui.BorderSingle},
+		runetui.Text("Hello", runetui.TextProps{Key: "greeting"}),
+	)
+}
+
+func TestAssertLayout_Explain_MatchingLayout_Passes(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLayout_Explain(rec, explainRoot, 80, 24, "greeting", runetui.Layout{X: 1, Y: 1, Width: 5, Height: 1})
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertLayout_Explain_Mismatch_IncludesExplainReport(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLayout_Explain(rec, explainRoot, 80, 24, "greeting", runetui.Layout{X: 0, Y: 0, Width: 5, Height: 1})
+
+	if len(rec.errors) != 1 {
+		t.Fatalf("expected 1 failure, got %d", len(rec.errors))
+	}
+	if !strings.Contains(rec.errors[0], "[panel] available=80x24") || !strings.Contains(rec.errors[0], "[greeting]") {
+		t.Errorf("expected explain report in failure, got %q", rec.errors[0])
+	}
+}
+
+func TestAssertLayout_Explain_MissingKey_ReportsIt(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLayout_Explain(rec, explainRoot, 80, 24, "missing", runetui.Layout{})
+
+	if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], `no component with key "missing"`) {
+		t.Errorf("expected missing key failure, got %v", rec.errors)
+	}
+}
+
+func TestAssertNoOverflow_ChildrenInsideParent_Passes(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	tree := runetui.NewLayoutEngine(80, 24).CalculateLayout(explainRoot())
+
+	AssertNoOverflow(rec, tree)
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertNoOverflow_ReportsEveryViolationWithKeys(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	wide := &runetui.LayoutTree{
+		Component: runetui.Text("wide", runetui.TextProps{Key: "wide"}),
+		Layout:    runetui.Layout{X: 5, Y: 0, Width: 10, Height: 1},
+	}
+	tall := &runetui.LayoutTree{
+		Component: runetui.Text("tall"),
+		Layout:    runetui.Layout{X: 0, Y: 1, Width: 2, Height: 4},
+	}
+	tree := &runetui.LayoutTree{
+		Component: runetui.Box(runetui.BoxProps{Key: "parent"}),
+		Layout:    runetui.Layout{X: 0, Y: 0, Width: 10, Height: 3},
+		Children:  []*runetui.LayoutTree{wide, tall},
+	}
+
+	AssertNoOverflow(rec, tree)
+
+	if len(rec.errors) != 2 {
+		t.Fatalf("expected 2 violations, got %d: %v", len(rec.errors), rec.errors)
+	}
+	if !strings.Contains(rec.errors[0], `"wide" {X:5 Y:0 Width:10 Height:1} overflows parent "parent"`) {
+		t.Errorf("unexpected first violation: %q", rec.errors[0])
+	}
+	if !strings.Contains(rec.errors[1], "*runetui.text {X:0 Y:1 Width:2 Height:4}") {
+		t.Errorf("unexpected second violation: %q", rec.errors[1])
+	}
+}
+
+func TestAssertNoOverflow_NestedViolation_IsFound(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	grandchild := &runetui.LayoutTree{
+		Component: runetui.Text("x", runetui.TextProps{Key: "deep"}),
+		Layout:    runetui.Layout{X: 0, Y: 0, Width: 8, Height: 1},
+	}
+	child := &runetui.LayoutTree{
+		Component: runetui.Box(runetui.BoxProps{Key: "inner"}),
+		Layout:    runetui.Layout{X: 0, Y: 0, Width: 4, Height: 1},
+		Children:  []*runetui.LayoutTree{grandchild},
+	}
+	tree := &runetui.LayoutTree{
+		Component: runetui.Box(runetui.BoxProps{Key: "outer"}),
+		Layout:    runetui.Layout{X: 0, Y: 0, Width: 10, Height: 1},
+		Children:  []*runetui.LayoutTree{child},
+	}
+
+	AssertNoOverflow(rec, tree)
+
+	if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], `"deep"`) || !strings.Contains(rec.errors[0], `parent "inner"`) {
+		t.Errorf("expected nested violation, got %v", rec.errors)
+	}
+}
+
+func TestAssertNoOverflow_NilTree_Passes(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertNoOverflow(rec, nil)
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertSnapshot_UpdateEnv_RewritesGoldenFile(t *testing.T) {
+	name := "test_update_env"
+	goldenFile := filepath.Join("testdata", name+".golden")
+	t.Cleanup(func() { os.Remove(goldenFile) })
+	if err := os.WriteFile(goldenFile, []byte("stale"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(UpdateGoldenEnv, "1")
+
+	AssertSnapshot(t, name, "fresh")
+
+	content, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "fresh" {
+		t.Errorf("expected golden file to be updated, got %q", content)
+	}
+}
+
+func TestShouldUpdateGolden_EnvValues(t *testing.T) {
+	tests := map[string]bool{"": false, "0": false, "false": false, "FALSE": false, "1": true, "true": true}
+	for value, expected := range tests {
+		t.Setenv(UpdateGoldenEnv, value)
+		if got := shouldUpdateGolden(); got != expected {
+			t.Errorf("%s=%q: expected %v, got %v", UpdateGoldenEnv, value, expected, got)
+		}
+	}
+}
+
+func TestUpdateAllGolden_InsideUpdateRun_IsNoOp(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	t.Setenv(UpdateGoldenEnv, "1")
+
+	UpdateAllGolden(rec, filepath.Join(t.TempDir(), "testdata"))
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestReadGoldenFiles_CollectsOnlyGoldenFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "nested"), 0755)
+	os.WriteFile(filepath.Join(dir, "a.golden"), []byte("a"), 0644)
+	os.WriteFile(filepath.Join(dir, "nested", "b.golden"), []byte("b"), 0644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0644)
+
+	files, err := readGoldenFiles(dir)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[filepath.Join(dir, "nested", "b.golden")] != "b" {
+		t.Errorf("unexpected golden files: %v", files)
+	}
+}
+
+func TestReadGoldenFiles_MissingDir_ReturnsEmpty(t *testing.T) {
+	files, err := readGoldenFiles(filepath.Join(t.TempDir(), "missing"))
+
+	if err != nil || len(files) != 0 {
+		t.Errorf("expected empty result, got %v, %v", files, err)
+	}
+}
+
+func TestAssertNoOverlap_StackedSiblings_Passes(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	root := runetui.HStack(
+		runetui.Text("left", runetui.TextProps{Key: "left"}),
+		runetui.VStack(runetui.Text("a"), runetui.Text("b")),
+	)
+	tree := runetui.NewLayoutEngine(80, 24).CalculateLayout(root)
+
+	AssertNoOverlap(rec, tree)
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertNoOverlap_ReportsOverlappingSiblingsWithKeys(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	tree := &runetui.LayoutTree{
+		Component: runetui.Box(runetui.BoxProps{Key: "row"}),
+		Layout:    runetui.Layout{Width: 10, Height: 1},
+		Children: []*runetui.LayoutTree{
+			{Component: runetui.Text("a", runetui.TextProps{Key: "a"}), Layout: runetui.Layout{X: 0, Width: 4, Height: 1}},
+			{Component: runetui.Text("b", runetui.TextProps{Key: "b"}), Layout: runetui.Layout{X: 3, Width: 4, Height: 1}},
+			{Component: runetui.Text("c", runetui.TextProps{Key: "c"}), Layout: runetui.Layout{X: 6, Width: 2, Height: 1}},
+		},
+	}
+
+	AssertNoOverlap(rec, tree)
+
+	if len(rec.errors) != 2 {
+		t.Fatalf("expected 2 violations, got %d: %v", len(rec.errors), rec.errors)
+	}
+	if rec.errors[0] != `"a" {X:0 Y:0 Width:4 Height:1} overlaps sibling "b" {X:3 Y:0 Width:4 Height:1}` {
+		t.Errorf("unexpected first violation: %q", rec.errors[0])
+	}
+	if !strings.Contains(rec.errors[1], `"b"`) || !strings.Contains(rec.errors[1], `sibling "c"`) {
+		t.Errorf("unexpected second violation: %q", rec.errors[1])
+	}
+}
+
+func TestAssertNoOverlap_AdjacentAndEmptyNodes_Pass(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	tree := &runetui.LayoutTree{
+		Component: runetui.Box(runetui.BoxProps{}),
+		Children: []*runetui.LayoutTree{
+			{Component: runetui.Text("a"), Layout: runetui.Layout{Y: 0, Width: 4, Height: 1}},
+			{Component: runetui.Text("b"), Layout: runetui.Layout{Y: 1, Width: 4, Height: 1}},
+			{Component: runetui.Box(runetui.BoxProps{}), Layout: runetui.Layout{Y: 0}},
+		},
+	}
+
+	AssertNoOverlap(rec, tree)
+	AssertNoOverlap(rec, nil)
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertGoldenDir_CreatesMissingFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "variants")
+	rec := &recordingTB{TB: t}
+
+	AssertGoldenDir(rec, dir, map[string]string{"narrow": "ab", "wide": "abcd"})
+
+	if len(rec.errors) != 0 {
+		t.Fatalf("expected no failures, got %v", rec.errors)
+	}
+	for name, expected := range map[string]string{"narrow": "ab", "wide": "abcd"} {
+		content, err := os.ReadFile(filepath.Join(dir, name+".golden"))
+		if err != nil || string(content) != expected {
+			t.Errorf("expected %s.golden to contain %q, got %q (%v)", name, expected, content, err)
+		}
+	}
+}
+
+func TestAssertGoldenDir_ReportsAllMismatchesAtOnce(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	dir := t.TempDir()
+	for name, content := range map[string]string{"a": "one", "b": "two", "c": "three"} {
+		if err := os.WriteFile(filepath.Join(dir, name+".golden"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rec := &recordingTB{TB: t}
+
+	AssertGoldenDir(rec, dir, map[string]string{"a": "one", "b": "TWO", "c": "THREE"})
+
+	if len(rec.errors) != 1 {
+		t.Fatalf("expected one aggregated failure, got %d: %v", len(rec.errors), rec.errors)
+	}
+	report := rec.errors[0]
+	if !strings.Contains(report, "2 of 3 snapshots") {
+		t.Errorf("expected mismatch count in report, got:\n%s", report)
+	}
+	if strings.Contains(report, "a.golden") || !strings.Contains(report, "b.golden") || !strings.Contains(report, "c.golden") {
+		t.Errorf("expected only b and c in report, got:\n%s", report)
+	}
+	if strings.Index(report, "b.golden") > strings.Index(report, "c.golden") {
+		t.Errorf("expected mismatches in name order, got:\n%s", report)
+	}
+}
+
+func TestRenderToLines_SplitsOutputIntoLines(t *testing.T) {
+	rootFunc := func() runetui.Component {
+		return runetui.Box(runetui.BoxProps{Border: runetui.BorderSingle}, runetui.Text("Hi"))
+	}
+
+	lines := RenderToLines(rootFunc, 20, 5)
+
+	AssertLineCount(t, lines, 3)
+	AssertLine(t, lines, 0, "┌──┐")
+	AssertLine(t, lines, 1, "│Hi│")
+	AssertLine(t, lines, 2, "└──┘")
+}
+
+func TestRenderToLines_EmptyOutput_ReturnsNil(t *testing.T) {
+	lines := RenderToLines(func() runetui.Component { return runetui.Text("") }, 20, 5)
+
+	if lines != nil {
+		t.Errorf("expected nil, got %q", lines)
+	}
+}
+
+func TestAssertLine_Mismatch_ShowsActualLines(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLine(rec, []string{"first", "second"}, 1, "other")
+
+	if len(rec.errors) != 1 {
+		t.Fatalf("expected 1 failure, got %v", rec.errors)
+	}
+	if !strings.Contains(rec.errors[0], `"second"`) || !strings.Contains(rec.errors[0], "  0| first") {
+		t.Errorf("expected the actual line and the numbered lines, got %q", rec.errors[0])
+	}
+}
+
+func TestAssertLine_IndexOutOfRange_Fails(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLine(rec, []string{"only"}, 3, "only")
+
+	if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], "out of range") {
+		t.Errorf("expected an out of range failure, got %v", rec.errors)
+	}
+}
+
+func TestAssertLineCount_Mismatch_Fails(t *testing.T) {
+	rec := &recordingTB{TB: t}
+
+	AssertLineCount(rec, []string{"a", "b"}, 3)
+
+	if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], "expected 3 lines, got 2") {
+		t.Errorf("expected a line count failure, got %v", rec.errors)
+	}
+}
+
+func TestAssertContainsAt_RenderedBox(t *testing.T) {
+	output := RenderToString(explainRoot, 20, 5)
+
+	AssertContainsAt(t, output, 0, 0, "┌")
+	AssertContainsAt(t, output, 1, 1, "Hello")
+}
+
+func TestDiff_ChangedLine_ShowsUnifiedHunk(t *testing.T) {
+	t.Setenv(DiffContextEnv, "")
+
+	got := diff("a\nb\nc", "a\nB\nc")
+
+	expected := "--- expected\n+++ got\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestDiff_InsertedAndRemovedLines(t *testing.T) {
+	t.Setenv(DiffContextEnv, "0")
+
+	got := diff("a\nb\nc", "a\nc\nd")
+
+	expected := "--- expected\n+++ got\n@@ -2,1 +2,0 @@\n-b\n@@ -4,0 +3,1 @@\n+d\n"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestDiff_DistantChanges_SplitIntoHunksWithDefaultContext(t *testing.T) {
+	t.Setenv(DiffContextEnv, "")
+	lines := make([]string, 20)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	changed := append([]string(nil), lines...)
+	changed[1] = "changed 1"
+	changed[18] = "changed 18"
+
+	got := diff(strings.Join(lines, "\n"), strings.Join(changed, "\n"))
+
+	if n := strings.Count(got, "@@ -"); n != 2 {
+		t.Errorf("expected 2 hunks, got %d:\n%s", n, got)
+	}
+	if strings.Contains(got, "line 10") {
+		t.Errorf("expected lines far from changes to be omitted, got:\n%s", got)
+	}
+	if !strings.Contains(got, " line 4\n") || strings.Contains(got, " line 5\n") {
+		t.Errorf("expected 3 lines of context, got:\n%s", got)
+	}
+}
+
+func TestDiff_ContextFromEnvironment(t *testing.T) {
+	t.Setenv(DiffContextEnv, "1")
+
+	got := diff("a\nb\nc\nd\ne", "a\nb\nC\nd\ne")
+
+	expected := "--- expected\n+++ got\n@@ -2,3 +2,3 @@\n b\n-c\n+C\n d\n"
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestAssertSnapshot_Mismatch_ReportsDiff(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	name := "diff_report_menu"
+	golden := filepath.Join("testdata", name+".golden")
+	if err := os.WriteFile(golden, []byte("File\nEdit\nView"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Remove(golden) })
+	rec := &recordingTB{TB: t}
+
+	AssertSnapshot(rec, name, "File\nEdit\nHelp")
+
+	if len(rec.errors) != 1 {
+		t.Fatalf("expected 1 failure, got %v", rec.errors)
+	}
+	if !strings.Contains(rec.errors[0], "-View\n+Help") {
+		t.Errorf("expected a unified diff, got:\n%s", rec.errors[0])
+	}
+}
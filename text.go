@@ -1,6 +1,9 @@
 package runetui
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
 )
@@ -10,6 +13,11 @@ func init() {
 }
 
 // TextProps defines properties for the Text component.
+// OverflowChar, when set, marks text cut off by WrapTruncate by replacing the
+// last visible cell; when zero the text is cut without an indicator.
+// WhiteSpace WhiteSpacePre keeps newlines, spaces and tabs as written and
+// ignores Wrap; WhiteSpaceNowrap joins all lines into one. Both clip lines to
+// the layout width instead of wrapping.
 type TextProps struct {
 	Content       string
 	Color         string
@@ -19,7 +27,12 @@ type TextProps struct {
 	Underline     bool
 	Strikethrough bool
 	Wrap          WrapMode
+	OverflowChar  rune
+	WhiteSpace    WhiteSpaceMode
 	Align         TextAlign
+	Prefix        string
+	Suffix        string
+	NoColor       bool
 	Key           string
 }
 
@@ -28,6 +41,7 @@ func (TextProps) isProps() {}
 type text struct {
 	content string
 	props   TextProps
+	memo    renderMemo[TextProps]
 }
 
 // Text creates a new text component with the given content and optional properties.
@@ -44,8 +58,133 @@ func Text(content string, props ...TextProps) Component {
 }
 
 func (t *text) Render(layout Layout) string {
+	output := t.render(layout)
+	t.memo.store(t.props, layout, output)
+	return output
+}
+
+// Dirty reports whether the props changed since the last render.
+func (t *text) Dirty() bool {
+	return t.memo.dirty(t.props)
+}
+
+func (t *text) lastRender(layout Layout) (string, bool) {
+	return t.memo.lookup(layout)
+}
+
+func (t *text) render(layout Layout) string {
 	style := lipgloss.NewStyle()
+	if !t.props.NoColor && !noColorRender {
+		style = t.applyAttributes(style)
+	}
+
+	style = style.Width(layout.Width)
+
+	if t.props.WhiteSpace != WhiteSpacePre {
+		switch t.props.Wrap {
+		case WrapWord:
+			style = style.MaxWidth(layout.Width)
+		case WrapTruncate:
+			style = style.MaxWidth(layout.Width).Inline(true)
+		}
+	}
+
+	switch t.props.Align {
+	case TextAlignLeft:
+		style = style.Align(lipgloss.Left)
+	case TextAlignCenter:
+		style = style.Align(lipgloss.Center)
+	case TextAlignRight:
+		style = style.Align(lipgloss.Right)
+	}
+
+	content := t.props.Prefix + t.content + t.props.Suffix
+	switch t.props.WhiteSpace {
+	case WhiteSpacePre:
+		style = style.TabWidth(lipgloss.NoTabConversion)
+	case WhiteSpaceNowrap:
+		content = strings.ReplaceAll(content, "\n", " ")
+	}
+	if (t.props.Wrap == WrapNone || t.props.WhiteSpace != WhiteSpaceNormal) && layout.Width > 0 {
+		// Clip before styling; a width on the style would otherwise wrap.
+		content = truncateLines(content, layout.Width)
+	}
+	if t.props.Wrap == WrapOverflow && t.props.WhiteSpace == WhiteSpaceNormal && layout.Width > 0 {
+		content = clipRows(wrapChars(content, layout.Width), layout.Height)
+	}
+	if t.props.Wrap == WrapTruncate && t.props.OverflowChar != 0 && layout.Width > 0 {
+		content = markOverflow(content, layout.Width, t.props.OverflowChar)
+	}
+
+	return style.Render(content)
+}
+
+// wrapChars breaks every line of content into rows of at most width cells,
+// splitting between characters rather than at word boundaries. ANSI escape
+// sequences are kept and take up no cells.
+func wrapChars(content string, width int) string {
+	var out strings.Builder
+	for i, line := range strings.Split(content, "\n") {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		escapes := ansiPattern.FindAllStringIndex(line, -1)
+		column := 0
+		for pos := 0; pos < len(line); {
+			if len(escapes) > 0 && escapes[0][0] == pos {
+				out.WriteString(line[pos:escapes[0][1]])
+				pos = escapes[0][1]
+				escapes = escapes[1:]
+				continue
+			}
+			_, size := utf8.DecodeRuneInString(line[pos:])
+			cells := lipgloss.Width(line[pos : pos+size])
+			if column > 0 && column+cells > width {
+				out.WriteByte('\n')
+				column = 0
+			}
+			out.WriteString(line[pos : pos+size])
+			column += cells
+			pos += size
+		}
+	}
+	return out.String()
+}
 
+// clipRows keeps the first height lines of content. A height of 0 or less
+// keeps every line.
+func clipRows(content string, height int) string {
+	if height <= 0 {
+		return content
+	}
+	lines := strings.Split(content, "\n")
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markOverflow cuts each line of content that is wider than width to leave
+// room for mark, then appends mark to it. Lines cut to a width of 1 keep only
+// the mark, and a width of 0 or less leaves them empty.
+func markOverflow(content string, width int, mark rune) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		switch {
+		case lipgloss.Width(line) <= width:
+		case width <= 0:
+			lines[i] = ""
+		case width == 1:
+			lines[i] = string(mark)
+		default:
+			lines[i] = truncateLines(line, width-1) + string(mark)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// applyAttributes adds the color and text attribute props to style.
+func (t *text) applyAttributes(style lipgloss.Style) lipgloss.Style {
 	if t.props.Color != "" {
 		style = style.Foreground(lipgloss.Color(t.props.Color))
 	}
@@ -70,25 +209,7 @@ func (t *text) Render(layout Layout) string {
 		style = style.Strikethrough(true)
 	}
 
-	style = style.Width(layout.Width)
-
-	switch t.props.Wrap {
-	case WrapWord:
-		style = style.MaxWidth(layout.Width)
-	case WrapTruncate:
-		style = style.MaxWidth(layout.Width).Inline(true)
-	}
-
-	switch t.props.Align {
-	case TextAlignLeft:
-		style = style.Align(lipgloss.Left)
-	case TextAlignCenter:
-		style = style.Align(lipgloss.Center)
-	case TextAlignRight:
-		style = style.Align(lipgloss.Right)
-	}
-
-	return style.Render(t.content)
+	return style
 }
 
 func (t *text) Children() []Component {
@@ -99,13 +220,25 @@ func (t *text) Key() string {
 	return t.props.Key
 }
 
+func (t *text) label() string {
+	return "text"
+}
+
 func (t *text) Measure(availableWidth, availableHeight int) Size {
+	if t.props.WhiteSpace == WhiteSpacePre {
+		return t.measurePre()
+	}
+	if t.props.Wrap == WrapOverflow && t.props.WhiteSpace == WhiteSpaceNormal && availableWidth > 0 {
+		return t.measureOverflow(availableWidth, availableHeight)
+	}
+
 	lines := 1
-	width := len(t.content)
+	visible := StripANSI(t.content)
+	width := lipgloss.Width(visible) + lipgloss.Width(t.props.Prefix) + lipgloss.Width(t.props.Suffix)
 
-	if t.props.Wrap == WrapWord && width > availableWidth {
+	if t.props.Wrap == WrapWord && width > availableWidth && availableWidth > 0 {
+		lines = (width + availableWidth - 1) / availableWidth
 		width = availableWidth
-		lines = (len(t.content) + availableWidth - 1) / availableWidth
 	}
 
 	if t.props.Wrap == WrapTruncate && width > availableWidth {
@@ -118,3 +251,27 @@ func (t *text) Measure(availableWidth, availableHeight int) Size {
 		Height: lines,
 	}
 }
+
+// measureOverflow sizes WrapOverflow text the way render lays it out: broken
+// into rows of width cells and clipped to height rows.
+func (t *text) measureOverflow(width, height int) Size {
+	content := StripANSI(t.props.Prefix + t.content + t.props.Suffix)
+	lines := strings.Split(clipRows(wrapChars(content, width), height), "\n")
+	size := Size{Height: len(lines)}
+	for _, line := range lines {
+		size.Width = max(size.Width, lipgloss.Width(line))
+	}
+	return size
+}
+
+// measurePre sizes preformatted text: one row per line, as wide as the widest
+// line with the prefix and suffix.
+func (t *text) measurePre() Size {
+	lines := strings.Split(StripANSI(t.content), "\n")
+	width := 0
+	for _, line := range lines {
+		width = max(width, lipgloss.Width(line))
+	}
+	width += lipgloss.Width(t.props.Prefix) + lipgloss.Width(t.props.Suffix)
+	return Size{Width: width, Height: len(lines)}
+}
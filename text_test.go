@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 var updateGolden = flag.Bool("update", false, "update golden files")
@@ -210,6 +211,22 @@ func TestText_Measure_ReturnsCorrectSize(t *testing.T) {
 	}
 }
 
+func TestText_Measure_MultibyteRunes_CountsColumns(t *testing.T) {
+	size := Text("▶ ✓").Measure(10, 10)
+
+	if size.Width != 3 {
+		t.Errorf("Expected width 3, got %d", size.Width)
+	}
+}
+
+func TestText_Measure_WideRunes_CountsCells(t *testing.T) {
+	size := Text("日本").Measure(10, 10)
+
+	if size.Width != 4 {
+		t.Errorf("Expected width 4, got %d", size.Width)
+	}
+}
+
 func TestText_Measure_WithWrapWord_CalculatesMultipleLines(t *testing.T) {
 	text := Text("Hello World", TextProps{Wrap: WrapWord})
 	size := text.Measure(5, 10)
@@ -395,6 +412,7 @@ func TestText_WrapModes_ProducesValidOutput(t *testing.T) {
 	}{
 		{"word_wrap", WrapWord, "Hello World", 3},
 		{"truncate", WrapTruncate, "Hello World", 1},
+		{"overflow", WrapOverflow, "Hello World", 3},
 	}
 
 	for _, tt := range tests {
@@ -460,3 +478,209 @@ func compareWithGolden(t *testing.T, name, got string) {
 		t.Errorf("Output doesn't match golden file %s:\ngot:\n%q\n\nwant:\n%q\n\nRun 'go test -update' to update golden files", name, got, want)
 	}
 }
+
+func TestText_Measure_WithPreStyledContent_ReturnsVisibleWidth(t *testing.T) {
+	size := Text("\x1b[1mBold\x1b[0m").Measure(80, 24)
+
+	if size.Width != 4 {
+		t.Errorf("expected width 4, got %d", size.Width)
+	}
+}
+
+func TestText_PrefixAndSuffix_DecorateContent(t *testing.T) {
+	txt := Text("task", TextProps{Prefix: "› ", Suffix: " ✓"})
+
+	output := StripANSI(txt.Render(Layout{Width: 8, Height: 1}))
+
+	if output != "› task ✓" {
+		t.Errorf("expected %q, got %q", "› task ✓", output)
+	}
+}
+
+func TestText_PrefixAndSuffix_AddRuneWidthsToMeasure(t *testing.T) {
+	size := Text("task", TextProps{Prefix: "› ", Suffix: " ✓"}).Measure(80, 24)
+
+	if size.Width != 8 {
+		t.Errorf("expected width 8, got %d", size.Width)
+	}
+}
+
+func TestText_PrefixAndSuffix_CountTowardWrapping(t *testing.T) {
+	size := Text("abcd", TextProps{Prefix: "> ", Wrap: WrapWord}).Measure(3, 24)
+
+	if size.Width != 3 || size.Height != 2 {
+		t.Errorf("expected 3x2, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestText_WrapNone_ClipsToLayoutWidth(t *testing.T) {
+	txt := Text("\x1b[31mabcdefghijklmnopqrst\x1b[0m", TextProps{Bold: true})
+
+	output := txt.Render(Layout{Width: 10, Height: 1})
+
+	if VisualHeight(output) != 1 {
+		t.Fatalf("expected a single line, got %q", output)
+	}
+	if got := StripANSI(output); got != "abcdefghij" {
+		t.Errorf("expected 10 visible characters, got %q", got)
+	}
+	if stray := ansiPattern.ReplaceAllString(output, ""); strings.Contains(stray, "\x1b") {
+		t.Errorf("expected only complete escape sequences, got %q", output)
+	}
+}
+
+func TestText_WrapNone_ClipsEachLine(t *testing.T) {
+	txt := Text("first line is long\nshort", TextProps{Prefix: "> "})
+
+	output := StripANSI(txt.Render(Layout{Width: 8, Height: 2}))
+
+	if output != "> first \nshort   " {
+		t.Errorf("expected each line clipped to 8 cells, got %q", output)
+	}
+}
+
+func TestText_WrapTruncate_OverflowChar_MarksCutText(t *testing.T) {
+	txt := Text("Hello World", TextProps{Wrap: WrapTruncate, OverflowChar: '>'})
+
+	got := StripANSI(txt.Render(Layout{Width: 7, Height: 1}))
+
+	if got != "Hello >" {
+		t.Errorf("expected %q, got %q", "Hello >", got)
+	}
+}
+
+func TestText_WrapTruncate_OverflowChar_FitsText_Unchanged(t *testing.T) {
+	txt := Text("Hello", TextProps{Wrap: WrapTruncate, OverflowChar: '…'})
+
+	got := StripANSI(txt.Render(Layout{Width: 7, Height: 1}))
+
+	if got != "Hello  " {
+		t.Errorf("expected text without a mark, got %q", got)
+	}
+}
+
+func TestText_WrapTruncate_NoOverflowChar_CutsWithoutIndicator(t *testing.T) {
+	txt := Text("Hello World", TextProps{Wrap: WrapTruncate})
+
+	got := StripANSI(txt.Render(Layout{Width: 7, Height: 1}))
+
+	if got != "Hello W" {
+		t.Errorf("expected %q, got %q", "Hello W", got)
+	}
+}
+
+func TestMarkOverflow_NarrowWidths(t *testing.T) {
+	tests := []struct {
+		width    int
+		expected string
+	}{
+		{2, "H…"},
+		{1, "…"},
+		{0, ""},
+		{-1, ""},
+	}
+
+	for _, tt := range tests {
+		if got := markOverflow("Hello", tt.width, '…'); got != tt.expected {
+			t.Errorf("width %d: expected %q, got %q", tt.width, tt.expected, got)
+		}
+	}
+}
+
+func TestText_WhiteSpacePre_PreservesSpacesAndNewlines(t *testing.T) {
+	txt := Text("a  b\n  c", TextProps{WhiteSpace: WhiteSpacePre, Wrap: WrapTruncate})
+
+	size := txt.Measure(80, 24)
+	output := StripANSI(txt.Render(Layout{Width: size.Width, Height: size.Height}))
+
+	if size.Width != 4 || size.Height != 2 {
+		t.Errorf("expected 4x2, got %dx%d", size.Width, size.Height)
+	}
+	if output != "a  b\n  c " {
+		t.Errorf("expected spaces and newline preserved, got %q", output)
+	}
+}
+
+func TestText_WhiteSpacePre_ClipsInsteadOfWrapping(t *testing.T) {
+	txt := Text("one two three", TextProps{WhiteSpace: WhiteSpacePre, Wrap: WrapWord})
+
+	output := StripANSI(txt.Render(Layout{Width: 7, Height: 1}))
+
+	if output != "one two" {
+		t.Errorf("expected a single clipped line, got %q", output)
+	}
+}
+
+func TestText_WhiteSpaceNowrap_JoinsLines(t *testing.T) {
+	txt := Text("one\ntwo three", TextProps{WhiteSpace: WhiteSpaceNowrap})
+
+	output := StripANSI(txt.Render(Layout{Width: 9, Height: 1}))
+
+	if output != "one two t" {
+		t.Errorf("expected joined and clipped line, got %q", output)
+	}
+}
+
+func TestText_WrapOverflow_ClipsWithoutMarker(t *testing.T) {
+	txt := Text("Status: all systems nominal", TextProps{Wrap: WrapOverflow, OverflowChar: '…'})
+
+	size := txt.Measure(10, 5)
+	output := StripANSI(txt.Render(Layout{Width: size.Width, Height: size.Height}))
+
+	if size.Width != 10 {
+		t.Errorf("expected width 10, got %d", size.Width)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if utf8.RuneCountInString(line) > 10 {
+			t.Errorf("expected lines clipped to 10 cells, got %q", line)
+		}
+	}
+	if strings.Contains(output, "…") {
+		t.Errorf("expected no overflow marker, got %q", output)
+	}
+}
+
+func TestText_WrapOverflow_BreaksMidWordAndMeasuresRenderedRows(t *testing.T) {
+	txt := Text("Hello World", TextProps{Wrap: WrapOverflow})
+
+	size := txt.Measure(5, 10)
+	output := StripANSI(txt.Render(Layout{Width: size.Width, Height: size.Height}))
+
+	expected := "Hello\n Worl\nd    "
+	if output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+	if size.Height != VisualHeight(output) {
+		t.Errorf("expected Measure height %d to match rendered rows %d", size.Height, VisualHeight(output))
+	}
+}
+
+func TestText_WrapOverflow_ClipsToLayoutHeight(t *testing.T) {
+	txt := Text("abcdefghij", TextProps{Wrap: WrapOverflow})
+
+	size := txt.Measure(4, 2)
+	output := StripANSI(txt.Render(Layout{Width: 4, Height: 2}))
+
+	if output != "abcd\nefgh" {
+		t.Errorf("expected %q, got %q", "abcd\nefgh", output)
+	}
+	if size.Width != 4 || size.Height != 2 {
+		t.Errorf("expected 4x2, got %dx%d", size.Width, size.Height)
+	}
+}
+
+func TestWrapChars_KeepsANSISequences(t *testing.T) {
+	got := wrapChars("\x1b[1mabcd\x1b[0m", 2)
+
+	if got != "\x1b[1mab\ncd\x1b[0m" {
+		t.Errorf("expected escapes kept around the break, got %q", got)
+	}
+}
+
+func TestParseWrapMode_Overflow(t *testing.T) {
+	mode, err := ParseWrapMode("overflow")
+
+	if err != nil || mode != WrapOverflow {
+		t.Errorf("expected WrapOverflow, got %v, %v", mode, err)
+	}
+}
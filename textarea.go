@@ -0,0 +1,259 @@
+package runetui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TextareaProps defines properties for the Textarea component.
+// Cursor is the rune offset of the cursor in Value. ScrollY is the first
+// visible line; the view scrolls further when needed to keep the cursor
+// visible. MaxLength limits Value to that many runes when positive.
+// Placeholder is shown while Value is empty and the textarea is not focused.
+type TextareaProps struct {
+	Value       string
+	Cursor      int
+	Focused     bool
+	Width       Dimension
+	Height      Dimension
+	Placeholder string
+	MaxLength   int
+	ScrollY     int
+	Key         string
+}
+
+func (TextareaProps) isProps() {}
+
+// TextareaChangeMsg is dispatched when an edit or cursor movement changes
+// the textarea. The application owns Value, Cursor and ScrollY and should
+// store them in its UpdateFunc.
+type TextareaChangeMsg struct {
+	Value   string
+	Cursor  int
+	ScrollY int
+}
+
+// textarea is the private implementation of the Textarea component.
+type textarea struct {
+	props TextareaProps
+}
+
+// Textarea creates a multi-line text editor showing a viewport of its lines.
+// When focused, the character under the cursor is shown in reverse video.
+// Lines longer than the width are clipped. Use HandleTextareaKey in the
+// UpdateFunc for editing; the component implements TextValuer, so
+// c.(TextValuer).Value() returns the text.
+//
+// Example:
+//
+//	Textarea(TextareaProps{
+//	    Value:   state.draft,
+//	    Cursor:  state.cursor,
+//	    Focused: true,
+//	    Width:   DimensionFixed(40),
+//	    Height:  DimensionFixed(5),
+//	})
+func Textarea(props TextareaProps) Component {
+	props.Cursor = max(0, min(props.Cursor, len([]rune(props.Value))))
+	props.ScrollY = max(0, props.ScrollY)
+	return &textarea{props: props}
+}
+
+// TextValuer is an optional extension of Component.
+// Components that edit text, such as Textarea, implement it to return their
+// current value.
+type TextValuer interface {
+	Value() string
+}
+
+// Value returns the full multi-line text.
+func (t *textarea) Value() string {
+	return t.props.Value
+}
+
+// HandleTextareaKey returns the command for a key press on a focused
+// textarea: arrows, home and end move the cursor, enter inserts a newline,
+// backspace and delete remove characters and other keys insert their runes.
+// Each change dispatches TextareaChangeMsg. It returns nil for other
+// messages, when the textarea is not focused and for keys with no effect.
+func HandleTextareaKey(props TextareaProps, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || !props.Focused {
+		return nil
+	}
+
+	value := []rune(props.Value)
+	cursor := max(0, min(props.Cursor, len(value)))
+	row, col := textareaPosition(value, cursor)
+	lines := strings.Split(props.Value, "\n")
+
+	switch key.Type {
+	case tea.KeyLeft:
+		cursor = max(0, cursor-1)
+	case tea.KeyRight:
+		cursor = min(len(value), cursor+1)
+	case tea.KeyUp:
+		if row == 0 {
+			return nil
+		}
+		cursor = textareaOffset(lines, row-1, col)
+	case tea.KeyDown:
+		if row == len(lines)-1 {
+			return nil
+		}
+		cursor = textareaOffset(lines, row+1, col)
+	case tea.KeyHome:
+		cursor -= col
+	case tea.KeyEnd:
+		cursor += len([]rune(lines[row])) - col
+	case tea.KeyBackspace:
+		if cursor == 0 {
+			return nil
+		}
+		value = append(value[:cursor-1:cursor-1], value[cursor:]...)
+		cursor--
+	case tea.KeyDelete:
+		if cursor == len(value) {
+			return nil
+		}
+		value = append(value[:cursor:cursor], value[cursor+1:]...)
+	case tea.KeyEnter:
+		value, cursor = textareaInsert(value, cursor, []rune{'\n'}, props.MaxLength)
+	case tea.KeyRunes, tea.KeySpace:
+		runes := key.Runes
+		if key.Type == tea.KeySpace {
+			runes = []rune{' '}
+		}
+		value, cursor = textareaInsert(value, cursor, runes, props.MaxLength)
+	default:
+		return nil
+	}
+
+	if string(value) == props.Value && cursor == props.Cursor {
+		return nil
+	}
+	change := TextareaChangeMsg{Value: string(value), Cursor: cursor, ScrollY: props.ScrollY}
+	if height := resolveDimension(props.Height, 0); height > 0 {
+		row, _ := textareaPosition(value, cursor)
+		change.ScrollY = textareaScroll(props.ScrollY, row, height)
+	}
+	return func() tea.Msg { return change }
+}
+
+// textareaInsert inserts runes at cursor, dropping any that would take the
+// value past maxLength.
+func textareaInsert(value []rune, cursor int, runes []rune, maxLength int) ([]rune, int) {
+	if maxLength > 0 {
+		runes = runes[:max(0, min(len(runes), maxLength-len(value)))]
+	}
+	inserted := make([]rune, 0, len(value)+len(runes))
+	inserted = append(append(append(inserted, value[:cursor]...), runes...), value[cursor:]...)
+	return inserted, cursor + len(runes)
+}
+
+// textareaPosition converts a rune offset into a line and column.
+func textareaPosition(value []rune, cursor int) (row, col int) {
+	for _, r := range value[:cursor] {
+		if r == '\n' {
+			row, col = row+1, 0
+		} else {
+			col++
+		}
+	}
+	return row, col
+}
+
+// textareaOffset converts a line and column into a rune offset, clamping the
+// column to the line's length.
+func textareaOffset(lines []string, row, col int) int {
+	offset := 0
+	for _, line := range lines[:row] {
+		offset += len([]rune(line)) + 1
+	}
+	return offset + min(col, len([]rune(lines[row])))
+}
+
+// textareaScroll returns the first visible line that keeps row within a
+// viewport of height lines, starting from scrollY.
+func textareaScroll(scrollY, row, height int) int {
+	if row < scrollY {
+		return row
+	}
+	if row >= scrollY+height {
+		return row - height + 1
+	}
+	return scrollY
+}
+
+// Render generates the visible lines, each padded or clipped to the width.
+func (t *textarea) Render(layout Layout) string {
+	width, height := layout.Width, layout.Height
+	if width <= 0 || height <= 0 {
+		size := t.Measure(layout.Width, layout.Height)
+		width, height = max(width, size.Width), max(height, size.Height)
+	}
+
+	if t.props.Value == "" && t.props.Placeholder != "" && !t.props.Focused {
+		placeholder := Text(t.props.Placeholder, TextProps{Color: "#808080", Wrap: WrapNone})
+		return placeholder.Render(Layout{Width: width}) + strings.Repeat("\n"+strings.Repeat(" ", width), height-1)
+	}
+
+	value := []rune(t.props.Value)
+	cursorRow, cursorCol := textareaPosition(value, t.props.Cursor)
+	lines := strings.Split(t.props.Value, "\n")
+	scroll := textareaScroll(t.props.ScrollY, cursorRow, height)
+
+	cursorStyle := lipgloss.NewStyle().Reverse(true)
+	rendered := make([]string, height)
+	for i := range rendered {
+		row := scroll + i
+		var line []rune
+		if row < len(lines) {
+			line = []rune(lines[row])
+		}
+		line = append(line, []rune(strings.Repeat(" ", max(0, width-len(line))))...)[:width]
+		if !t.props.Focused || row != cursorRow || cursorCol >= width {
+			rendered[i] = string(line)
+			continue
+		}
+		rendered[i] = string(line[:cursorCol]) + cursorStyle.Render(string(line[cursorCol])) + string(line[cursorCol+1:])
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// Children returns an empty slice since textareas have no children.
+func (t *textarea) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (t *textarea) Key() string {
+	return t.props.Key
+}
+
+func (t *textarea) label() string {
+	return "textarea"
+}
+
+// Measure returns the resolved Width and Height. An auto width fits the
+// longest line plus a column for the cursor, and an auto height fits all
+// lines.
+func (t *textarea) Measure(availableWidth, availableHeight int) Size {
+	lines := strings.Split(t.props.Value, "\n")
+	size := Size{
+		Width:  resolveDimension(t.props.Width, availableWidth),
+		Height: resolveDimension(t.props.Height, availableHeight),
+	}
+	if size.Width == 0 {
+		size.Width = len([]rune(t.props.Placeholder))
+		for _, line := range lines {
+			size.Width = max(size.Width, len([]rune(line))+1)
+		}
+	}
+	if size.Height == 0 {
+		size.Height = len(lines)
+	}
+	return size
+}
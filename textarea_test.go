@@ -0,0 +1,166 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func textareaChange(t *testing.T, props TextareaProps, msg tea.KeyMsg) TextareaChangeMsg {
+	t.Helper()
+	cmd := HandleTextareaKey(props, msg)
+	if cmd == nil {
+		t.Fatalf("expected a command for %v", msg)
+	}
+	change, ok := cmd().(TextareaChangeMsg)
+	if !ok {
+		t.Fatalf("expected TextareaChangeMsg, got %T", cmd())
+	}
+	return change
+}
+
+func TestHandleTextareaKey_InsertsRunesAndNewlines(t *testing.T) {
+	props := TextareaProps{Value: "ab", Cursor: 1, Focused: true}
+
+	change := textareaChange(t, props, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("xy")})
+	if change.Value != "axyb" || change.Cursor != 3 {
+		t.Errorf("expected %q at 3, got %q at %d", "axyb", change.Value, change.Cursor)
+	}
+
+	change = textareaChange(t, props, tea.KeyMsg{Type: tea.KeyEnter})
+	if change.Value != "a\nb" || change.Cursor != 2 {
+		t.Errorf("expected newline inserted, got %q at %d", change.Value, change.Cursor)
+	}
+}
+
+func TestHandleTextareaKey_BackspaceAndDelete(t *testing.T) {
+	props := TextareaProps{Value: "a\nb", Cursor: 2, Focused: true}
+
+	change := textareaChange(t, props, tea.KeyMsg{Type: tea.KeyBackspace})
+	if change.Value != "ab" || change.Cursor != 1 {
+		t.Errorf("expected lines joined, got %q at %d", change.Value, change.Cursor)
+	}
+
+	change = textareaChange(t, props, tea.KeyMsg{Type: tea.KeyDelete})
+	if change.Value != "a\n" || change.Cursor != 2 {
+		t.Errorf("expected character after cursor removed, got %q at %d", change.Value, change.Cursor)
+	}
+
+	if HandleTextareaKey(TextareaProps{Value: "a", Focused: true}, tea.KeyMsg{Type: tea.KeyBackspace}) != nil {
+		t.Error("expected no command for backspace at the start")
+	}
+}
+
+func TestHandleTextareaKey_ArrowsMoveBetweenLines(t *testing.T) {
+	props := TextareaProps{Value: "hello\nhi\nworld", Cursor: 4, Focused: true}
+
+	tests := []struct {
+		key      tea.KeyType
+		expected int
+	}{
+		{tea.KeyDown, 8}, // column clamped to the end of "hi"
+		{tea.KeyLeft, 3},
+		{tea.KeyRight, 5},
+		{tea.KeyHome, 0},
+		{tea.KeyEnd, 5},
+	}
+	for _, tt := range tests {
+		if change := textareaChange(t, props, tea.KeyMsg{Type: tt.key}); change.Cursor != tt.expected {
+			t.Errorf("%v: expected cursor %d, got %d", tt.key, tt.expected, change.Cursor)
+		}
+	}
+
+	props.Cursor = 13
+	if change := textareaChange(t, props, tea.KeyMsg{Type: tea.KeyUp}); change.Cursor != 8 {
+		t.Errorf("up: expected cursor 8, got %d", change.Cursor)
+	}
+	if HandleTextareaKey(props, tea.KeyMsg{Type: tea.KeyDown}) != nil {
+		t.Error("expected no command for down on the last line")
+	}
+}
+
+func TestHandleTextareaKey_MaxLength(t *testing.T) {
+	props := TextareaProps{Value: "abc", Cursor: 3, Focused: true, MaxLength: 4}
+
+	change := textareaChange(t, props, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("xyz")})
+	if change.Value != "abcx" {
+		t.Errorf("expected value capped at 4 runes, got %q", change.Value)
+	}
+
+	props.Value, props.Cursor = "abcd", 4
+	if HandleTextareaKey(props, tea.KeyMsg{Type: tea.KeyEnter}) != nil {
+		t.Error("expected no command when the value is full")
+	}
+}
+
+func TestHandleTextareaKey_ScrollsToKeepCursorVisible(t *testing.T) {
+	props := TextareaProps{Value: "1\n2\n3", Cursor: 3, Focused: true, Height: DimensionFixed(2)}
+
+	change := textareaChange(t, props, tea.KeyMsg{Type: tea.KeyDown})
+
+	if change.ScrollY != 1 {
+		t.Errorf("expected ScrollY 1, got %d", change.ScrollY)
+	}
+}
+
+func TestHandleTextareaKey_Unfocused_ReturnsNil(t *testing.T) {
+	if HandleTextareaKey(TextareaProps{Value: "a"}, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")}) != nil {
+		t.Error("expected no command when not focused")
+	}
+}
+
+func TestTextarea_Render_ViewportAndCursor(t *testing.T) {
+	c := Textarea(TextareaProps{Value: "one\ntwo\nthree", Cursor: 9, Focused: true, ScrollY: 1})
+
+	output := c.Render(Layout{Width: 6, Height: 2})
+
+	if StripANSI(output) != "two   \nthree " {
+		t.Errorf("unexpected viewport %q", StripANSI(output))
+	}
+	if !strings.Contains(output, "\x1b[7mh\x1b[0m") {
+		t.Errorf("expected reverse-video cursor on %q, got %q", "h", output)
+	}
+}
+
+func TestTextarea_Render_ScrollsToCursor(t *testing.T) {
+	c := Textarea(TextareaProps{Value: "a\nb\nc\nd", Cursor: 0, ScrollY: 2})
+
+	if output := c.Render(Layout{Width: 1, Height: 2}); output != "a\nb" {
+		t.Errorf("expected the cursor line to be visible, got %q", output)
+	}
+}
+
+func TestTextarea_Render_Placeholder(t *testing.T) {
+	c := Textarea(TextareaProps{Placeholder: "Write…"})
+
+	output := StripANSI(c.Render(Layout{Width: 8, Height: 2}))
+
+	if output != "Write…  \n        " {
+		t.Errorf("unexpected placeholder %q", output)
+	}
+}
+
+func TestTextarea_Measure(t *testing.T) {
+	auto := Textarea(TextareaProps{Value: "abc\nde"}).Measure(80, 24)
+	if auto.Width != 4 || auto.Height != 2 {
+		t.Errorf("expected 4x2 for auto dimensions, got %dx%d", auto.Width, auto.Height)
+	}
+
+	fixed := Textarea(TextareaProps{Width: DimensionFixed(30), Height: DimensionPercent(50)}).Measure(80, 24)
+	if fixed.Width != 30 || fixed.Height != 12 {
+		t.Errorf("expected 30x12 for resolved dimensions, got %dx%d", fixed.Width, fixed.Height)
+	}
+}
+
+func TestTextarea_Value(t *testing.T) {
+	c := Textarea(TextareaProps{Value: "line 1\nline 2", Key: "notes"})
+
+	valuer, ok := c.(TextValuer)
+	if !ok {
+		t.Fatal("expected Textarea to implement TextValuer")
+	}
+	if valuer.Value() != "line 1\nline 2" || c.Key() != "notes" {
+		t.Errorf("unexpected value or key")
+	}
+}
@@ -0,0 +1,181 @@
+package runetui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TextInputProps defines properties for the TextInput component.
+// Width is the field width without the border; the field fits its content
+// when it is zero. Mask, when set, replaces every character of Value, as in
+// password fields. MaxLength limits Value to that many runes when positive.
+type TextInputProps struct {
+	Value            string
+	Placeholder      string
+	PlaceholderColor string
+	Focused          bool
+	CursorChar       string
+	MaxLength        int
+	Mask             rune
+	Background       string
+	BorderStyle      BorderStyle
+	Width            int
+	Key              string
+}
+
+func (TextInputProps) isProps() {}
+
+// TextInputChangeMsg is dispatched when a key press edits the input's value.
+// The application owns Value and should store it in its UpdateFunc.
+type TextInputChangeMsg struct {
+	Value string
+}
+
+// textInput is the private implementation of the TextInput component.
+type textInput struct {
+	props TextInputProps
+}
+
+// TextInput creates a single-line text field. When focused, CursorChar
+// (default "│") follows the value; when unfocused and empty, the placeholder
+// is shown instead. Values wider than Width are clipped, keeping the end
+// visible while focused. Use HandleTextInputKey in the UpdateFunc for
+// editing.
+//
+// Example:
+//
+//	TextInput(TextInputProps{
+//	    Value:       state.password,
+//	    Placeholder: "Password",
+//	    Mask:        '*',
+//	    Focused:     true,
+//	    Width:       20,
+//	    BorderStyle: BorderRounded,
+//	})
+func TextInput(props TextInputProps) Component {
+	if props.CursorChar == "" {
+		props.CursorChar = "│"
+	}
+	if props.PlaceholderColor == "" {
+		props.PlaceholderColor = "#808080"
+	}
+	return &textInput{props: props}
+}
+
+// HandleTextInputKey returns the command for a key press on a focused text
+// input: runes and space are appended up to MaxLength and backspace removes
+// the last character, each dispatching TextInputChangeMsg. It returns nil for
+// other messages and keys, and when the input is not focused.
+func HandleTextInputKey(props TextInputProps, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || !props.Focused {
+		return nil
+	}
+
+	value := []rune(props.Value)
+	switch key.Type {
+	case tea.KeyBackspace:
+		if len(value) == 0 {
+			return nil
+		}
+		value = value[:len(value)-1]
+	case tea.KeyRunes, tea.KeySpace:
+		runes := key.Runes
+		if key.Type == tea.KeySpace {
+			runes = []rune{' '}
+		}
+		if props.MaxLength > 0 {
+			runes = runes[:max(0, min(len(runes), props.MaxLength-len(value)))]
+		}
+		if len(runes) == 0 {
+			return nil
+		}
+		value = append(value, runes...)
+	default:
+		return nil
+	}
+
+	change := TextInputChangeMsg{Value: string(value)}
+	return func() tea.Msg { return change }
+}
+
+// display returns the visible value, masked if needed, with the cursor.
+func (t *textInput) display() string {
+	value := t.props.Value
+	if t.props.Mask != 0 {
+		value = strings.Repeat(string(t.props.Mask), len([]rune(value)))
+	}
+	if t.props.Focused {
+		value += t.props.CursorChar
+	}
+	return value
+}
+
+// showPlaceholder reports whether the placeholder replaces the value.
+func (t *textInput) showPlaceholder() bool {
+	return t.props.Value == "" && !t.props.Focused && t.props.Placeholder != ""
+}
+
+// fieldWidth returns Width, or the content width when Width is zero.
+func (t *textInput) fieldWidth() int {
+	if t.props.Width > 0 {
+		return t.props.Width
+	}
+	if t.showPlaceholder() {
+		return lipgloss.Width(t.props.Placeholder)
+	}
+	return lipgloss.Width(t.display())
+}
+
+// Render generates the field, padded or clipped to its width and wrapped in
+// the border, if any.
+func (t *textInput) Render(layout Layout) string {
+	width := t.fieldWidth()
+	content, style := t.display(), TextProps{Background: t.props.Background}
+	if t.showPlaceholder() {
+		content, style.Color = t.props.Placeholder, t.props.PlaceholderColor
+	}
+
+	runes := []rune(content)
+	if len(runes) > width {
+		if t.props.Focused {
+			runes = runes[len(runes)-width:]
+		} else {
+			runes = runes[:width]
+		}
+	}
+	line := string(runes) + strings.Repeat(" ", max(0, width-len(runes)))
+	field := Text(line, style).Render(Layout{})
+
+	if t.props.BorderStyle == BorderNone {
+		return field
+	}
+	return Box(BoxProps{Border: t.props.BorderStyle}, Text(field)).Render(Layout{Width: width + 2, Height: 3})
+}
+
+// Children returns an empty slice since text inputs have no children.
+func (t *textInput) Children() []Component {
+	return []Component{}
+}
+
+// Key returns the unique identifier for this component.
+func (t *textInput) Key() string {
+	return t.props.Key
+}
+
+func (t *textInput) label() string {
+	return "textinput"
+}
+
+// Measure returns Width, or the content width when Width is zero, and one
+// line, plus two columns and rows for a border.
+func (t *textInput) Measure(availableWidth, availableHeight int) Size {
+	size := Size{Width: t.fieldWidth(), Height: 1}
+	if t.props.BorderStyle != BorderNone {
+		size.Width += 2
+		size.Height += 2
+	}
+	return size
+}
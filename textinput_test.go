@@ -0,0 +1,111 @@
+package runetui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTextInput_Render_ValueCursorAndPadding(t *testing.T) {
+	tests := []struct {
+		props    TextInputProps
+		expected string
+	}{
+		{TextInputProps{Value: "abc", Width: 6}, "abc   "},
+		{TextInputProps{Value: "abc", Width: 6, Focused: true}, "abc│  "},
+		{TextInputProps{Value: "abc", Focused: true, CursorChar: "_"}, "abc_"},
+		{TextInputProps{Value: "secret", Mask: '*', Focused: true}, "******│"},
+		{TextInputProps{Value: "abcdef", Width: 4}, "abcd"},
+		{TextInputProps{Value: "abcdef", Width: 4, Focused: true}, "def│"},
+	}
+
+	for _, tt := range tests {
+		if output := StripANSI(TextInput(tt.props).Render(Layout{})); output != tt.expected {
+			t.Errorf("%+v: expected %q, got %q", tt.props, tt.expected, output)
+		}
+	}
+}
+
+func TestTextInput_Render_Placeholder(t *testing.T) {
+	unfocused := TextInput(TextInputProps{Placeholder: "Name", Width: 6}).Render(Layout{})
+	if StripANSI(unfocused) != "Name  " || !strings.Contains(unfocused, "38;2;128;128;128") {
+		t.Errorf("expected a gray placeholder, got %q", unfocused)
+	}
+
+	focused := StripANSI(TextInput(TextInputProps{Placeholder: "Name", Width: 6, Focused: true}).Render(Layout{}))
+	if focused != "│     " {
+		t.Errorf("expected the cursor instead of the placeholder, got %q", focused)
+	}
+}
+
+func TestTextInput_Render_BorderAndBackground(t *testing.T) {
+	output := TextInput(TextInputProps{Value: "hi", Width: 4, BorderStyle: BorderRounded, Background: "#223344"}).Render(Layout{})
+
+	expected := "╭────╮\n│hi  │\n╰────╯"
+	if StripANSI(output) != expected {
+		t.Errorf("expected %q, got %q", expected, StripANSI(output))
+	}
+	if !strings.Contains(output, "48;2;34;51;68") {
+		t.Errorf("expected background color, got %q", output)
+	}
+}
+
+func TestTextInput_Measure(t *testing.T) {
+	tests := []struct {
+		props    TextInputProps
+		expected Size
+	}{
+		{TextInputProps{Width: 20}, Size{Width: 20, Height: 1}},
+		{TextInputProps{Value: "abc", Focused: true}, Size{Width: 4, Height: 1}},
+		{TextInputProps{Placeholder: "Search"}, Size{Width: 6, Height: 1}},
+		{TextInputProps{Width: 10, BorderStyle: BorderSingle}, Size{Width: 12, Height: 3}},
+	}
+
+	for _, tt := range tests {
+		if size := TextInput(tt.props).Measure(80, 24); size != tt.expected {
+			t.Errorf("%+v: expected %+v, got %+v", tt.props, tt.expected, size)
+		}
+	}
+}
+
+func TestHandleTextInputKey_EditsValue(t *testing.T) {
+	props := TextInputProps{Value: "ab", Focused: true, MaxLength: 4}
+
+	tests := []struct {
+		msg      tea.KeyMsg
+		expected string
+	}{
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")}, "abc"},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("cdef")}, "abcd"},
+		{tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}, "ab "},
+		{tea.KeyMsg{Type: tea.KeyBackspace}, "a"},
+	}
+	for _, tt := range tests {
+		cmd := HandleTextInputKey(props, tt.msg)
+		if cmd == nil {
+			t.Fatalf("%v: expected a command", tt.msg)
+		}
+		if msg := cmd(); msg != (TextInputChangeMsg{Value: tt.expected}) {
+			t.Errorf("%v: expected %q, got %v", tt.msg, tt.expected, msg)
+		}
+	}
+}
+
+func TestHandleTextInputKey_ReturnsNil(t *testing.T) {
+	tests := []struct {
+		props TextInputProps
+		msg   tea.Msg
+	}{
+		{TextInputProps{Value: "ab"}, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")}},
+		{TextInputProps{Value: "abcd", Focused: true, MaxLength: 4}, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")}},
+		{TextInputProps{Focused: true}, tea.KeyMsg{Type: tea.KeyBackspace}},
+		{TextInputProps{Focused: true}, tea.KeyMsg{Type: tea.KeyEnter}},
+		{TextInputProps{Focused: true}, tea.WindowSizeMsg{}},
+	}
+	for _, tt := range tests {
+		if HandleTextInputKey(tt.props, tt.msg) != nil {
+			t.Errorf("%+v %v: expected nil", tt.props, tt.msg)
+		}
+	}
+}
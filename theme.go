@@ -0,0 +1,88 @@
+package runetui
+
+// Theme is a set of named colors that components read at render time, so an
+// application can restyle every component in one place. Colors are hex
+// strings such as "#7AA2F7", as accepted by the Color props of components.
+type Theme struct {
+	Primary    string
+	Secondary  string
+	Success    string
+	Warning    string
+	Error      string
+	Background string
+	Surface    string
+	OnPrimary  string
+	Text       string
+	TextMuted  string
+}
+
+// currentTheme is the theme for the render in progress, or nil for the
+// default. It is set by the app around each View, like the static manager.
+var currentTheme *Theme
+
+// setTheme sets the theme returned by CurrentTheme for subsequent renders.
+// A nil theme restores DefaultTheme.
+func setTheme(theme *Theme) {
+	currentTheme = theme
+}
+
+// CurrentTheme returns the theme of the app being rendered, as set with
+// WithTheme. Call it from component functions to pick colors. Outside of an
+// app's render, such as in tests using RenderToString, it returns
+// DefaultTheme.
+//
+// Example:
+//
+//	theme := runetui.CurrentTheme()
+//	runetui.Text("Saved", runetui.TextProps{Color: theme.Success})
+func CurrentTheme() Theme {
+	if currentTheme == nil {
+		return DefaultTheme()
+	}
+	return *currentTheme
+}
+
+// WithTheme sets the theme returned by CurrentTheme while the app renders.
+func WithTheme(theme Theme) AppOption {
+	return func(a *App) {
+		a.theme = &theme
+	}
+}
+
+// DefaultTheme returns the theme used when none is set. It is DarkTheme,
+// which suits the dark background most terminals use.
+func DefaultTheme() Theme {
+	return DarkTheme()
+}
+
+// DarkTheme returns a theme for terminals with a dark background.
+func DarkTheme() Theme {
+	return Theme{
+		Primary:    "#7AA2F7",
+		Secondary:  "#BB9AF7",
+		Success:    "#9ECE6A",
+		Warning:    "#E0AF68",
+		Error:      "#F7768E",
+		Background: "#1A1B26",
+		Surface:    "#24283B",
+		OnPrimary:  "#1A1B26",
+		Text:       "#C0CAF5",
+		TextMuted:  "#565F89",
+	}
+}
+
+// LightTheme returns a theme for terminals with a light background.
+func LightTheme() Theme {
+	return Theme{
+		Primary:    "#2E7DE9",
+		Secondary:  "#9854F1",
+		Success:    "#587539",
+		Warning:    "#8C6C3E",
+		Error:      "#F52A65",
+		Background: "#E1E2E7",
+		Surface:    "#D0D5E3",
+		OnPrimary:  "#FFFFFF",
+		Text:       "#3760BF",
+		TextMuted:  "#848CB5",
+	}
+}
@@ -0,0 +1,50 @@
+package runetui
+
+import "testing"
+
+func TestCurrentTheme_OutsideApp_ReturnsDefaultTheme(t *testing.T) {
+	if got := CurrentTheme(); got != DefaultTheme() {
+		t.Errorf("expected the default theme, got %+v", got)
+	}
+}
+
+func TestDefaultTheme_IsDarkTheme(t *testing.T) {
+	if DefaultTheme() != DarkTheme() {
+		t.Error("expected DefaultTheme to match DarkTheme")
+	}
+}
+
+func TestThemes_SetEveryColor(t *testing.T) {
+	for name, theme := range map[string]Theme{"dark": DarkTheme(), "light": LightTheme()} {
+		colors := []string{theme.Primary, theme.Secondary, theme.Success, theme.Warning, theme.Error,
+			theme.Background, theme.Surface, theme.OnPrimary, theme.Text, theme.TextMuted}
+		for i, color := range colors {
+			if _, _, _, ok := parseHexColor(color); !ok {
+				t.Errorf("%s theme: color %d is not a hex color: %q", name, i, color)
+			}
+		}
+	}
+}
+
+func TestWithTheme_ComponentsReadThemeDuringView(t *testing.T) {
+	var seen Theme
+	app := New(func() Component {
+		seen = CurrentTheme()
+		return Text("Hi", TextProps{Color: seen.Primary})
+	}, WithTheme(LightTheme()))
+
+	app.createModel().View()
+
+	if seen != LightTheme() {
+		t.Errorf("expected the light theme during View, got %+v", seen)
+	}
+}
+
+func TestWithTheme_ResetAfterView(t *testing.T) {
+	app := New(func() Component { return Text("Hi") }, WithTheme(LightTheme()))
+	app.createModel().View()
+
+	if got := CurrentTheme(); got != DefaultTheme() {
+		t.Errorf("expected the default theme after View, got %+v", got)
+	}
+}
@@ -1,5 +1,35 @@
 package runetui
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidDimension is returned or raised when a dimension value is out of range.
+var ErrInvalidDimension = errors.New("invalid dimension")
+
+// ErrInvalidBorderStyle is returned when a string does not name a border style.
+var ErrInvalidBorderStyle = errors.New("invalid border style")
+
+// ErrInvalidDirection is returned when a string does not name a direction.
+var ErrInvalidDirection = errors.New("invalid direction")
+
+// ErrInvalidAlign is returned when a string does not name an alignment.
+var ErrInvalidAlign = errors.New("invalid align")
+
+// ErrInvalidJustify is returned when a string does not name a justification.
+var ErrInvalidJustify = errors.New("invalid justify")
+
+// ErrInvalidWrapMode is returned when a string does not name a wrap mode.
+var ErrInvalidWrapMode = errors.New("invalid wrap mode")
+
+// ErrInvalidTextAlign is returned when a string does not name a text alignment.
+var ErrInvalidTextAlign = errors.New("invalid text align")
+
+// ErrInvalidWhiteSpace is returned when a string does not name a white space mode.
+var ErrInvalidWhiteSpace = errors.New("invalid white space mode")
+
 // Direction defines the layout direction for flex containers.
 type Direction int
 
@@ -10,7 +40,24 @@ const (
 	Row
 )
 
-// Dimension represents a sizing constraint (auto, fixed, or percentage).
+var directionNames = []string{"column", "row"}
+
+// String returns the lowercase name of the direction, e.g. "column".
+func (d Direction) String() string {
+	return enumName(directionNames, int(d), "Direction")
+}
+
+// ParseDirection converts a name such as "row" into a Direction.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseDirection(s string) (Direction, error) {
+	i, ok := parseEnumName(directionNames, s)
+	if !ok {
+		return Column, fmt.Errorf("%w: %q", ErrInvalidDirection, s)
+	}
+	return Direction(i), nil
+}
+
+// Dimension represents a sizing constraint (auto, fixed, percentage, or fill).
 type Dimension interface {
 	isDimension()
 }
@@ -55,10 +102,48 @@ func (d dimensionPercent) Value() int {
 }
 
 // DimensionPercent creates a percentage dimension (0-100).
+// It panics with ErrInvalidDimension if value is outside that range.
 func DimensionPercent(value int) Dimension {
+	if value < 0 || value > 100 {
+		panic(fmt.Errorf("%w: percent %d is outside 0-100", ErrInvalidDimension, value))
+	}
 	return dimensionPercent{value: value}
 }
 
+// dimensionClamp wraps a dimension and clamps its resolved value.
+type dimensionClamp struct {
+	dim Dimension
+	min int
+	max int
+}
+
+func (dimensionClamp) isDimension() {}
+
+// DimensionClamp wraps dim so that its resolved size stays within [min, max].
+// Auto dimensions still resolve to 0 so the component keeps its intrinsic size.
+// It panics with ErrInvalidDimension if min is greater than max.
+func DimensionClamp(dim Dimension, min, max int) Dimension {
+	if min > max {
+		panic(fmt.Errorf("%w: clamp min %d is greater than max %d", ErrInvalidDimension, min, max))
+	}
+	return dimensionClamp{dim: dim, min: min, max: max}
+}
+
+// dimensionFill represents the space left over by a box's siblings.
+type dimensionFill struct{}
+
+func (dimensionFill) isDimension() {}
+
+// DimensionFill sizes a box to take the space its siblings leave free along
+// the parent's main axis, like flex-grow: 1 in CSS. Several fill siblings
+// split that space equally. On the cross axis the box stretches to the
+// parent's inner size. The parent needs a fixed or percent size for there to
+// be free space; elsewhere a fill dimension sizes to content like
+// DimensionAuto.
+func DimensionFill() Dimension {
+	return dimensionFill{}
+}
+
 // Spacing defines space around an element (like CSS padding/margin).
 type Spacing struct {
 	Top    int
@@ -82,6 +167,27 @@ func SpacingHorizontal(value int) Spacing {
 	return Spacing{Left: value, Right: value}
 }
 
+// SpacingTopBottom creates spacing with distinct top and bottom values.
+func SpacingTopBottom(top, bottom int) Spacing {
+	return Spacing{Top: top, Bottom: bottom}
+}
+
+// SpacingLeftRight creates spacing with distinct left and right values.
+func SpacingLeftRight(left, right int) Spacing {
+	return Spacing{Left: left, Right: right}
+}
+
+// SpacingXY creates spacing with horizontal values on left and right and
+// vertical values on top and bottom.
+func SpacingXY(horizontal, vertical int) Spacing {
+	return Spacing{Top: vertical, Right: horizontal, Bottom: vertical, Left: horizontal}
+}
+
+// SpacingCSSLike creates spacing using the CSS shorthand order: top, right, bottom, left.
+func SpacingCSSLike(top, right, bottom, left int) Spacing {
+	return Spacing{Top: top, Right: right, Bottom: bottom, Left: left}
+}
+
 // BorderStyle defines the border rendering style.
 type BorderStyle int
 
@@ -96,6 +202,23 @@ const (
 	BorderRounded
 )
 
+var borderStyleNames = []string{"none", "single", "double", "rounded"}
+
+// String returns the lowercase name of the border style, e.g. "single".
+func (b BorderStyle) String() string {
+	return enumName(borderStyleNames, int(b), "BorderStyle")
+}
+
+// ParseBorderStyle converts a name such as "rounded" into a BorderStyle.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseBorderStyle(s string) (BorderStyle, error) {
+	i, ok := parseEnumName(borderStyleNames, s)
+	if !ok {
+		return BorderNone, fmt.Errorf("%w: %q", ErrInvalidBorderStyle, s)
+	}
+	return BorderStyle(i), nil
+}
+
 // Align defines cross-axis alignment in flex containers.
 type Align int
 
@@ -110,6 +233,23 @@ const (
 	AlignStretch
 )
 
+var alignNames = []string{"start", "center", "end", "stretch"}
+
+// String returns the lowercase name of the alignment, e.g. "center".
+func (a Align) String() string {
+	return enumName(alignNames, int(a), "Align")
+}
+
+// ParseAlign converts a name such as "stretch" into an Align.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseAlign(s string) (Align, error) {
+	i, ok := parseEnumName(alignNames, s)
+	if !ok {
+		return AlignStart, fmt.Errorf("%w: %q", ErrInvalidAlign, s)
+	}
+	return Align(i), nil
+}
+
 // Justify defines main-axis alignment in flex containers.
 type Justify int
 
@@ -124,8 +264,27 @@ const (
 	JustifySpaceBetween
 	// JustifySpaceAround distributes items with space around them.
 	JustifySpaceAround
+	// JustifySpaceEvenly distributes items with equal space between and around them.
+	JustifySpaceEvenly
 )
 
+var justifyNames = []string{"start", "center", "end", "space-between", "space-around", "space-evenly"}
+
+// String returns the lowercase name of the justification, e.g. "space-between".
+func (j Justify) String() string {
+	return enumName(justifyNames, int(j), "Justify")
+}
+
+// ParseJustify converts a name such as "space-around" into a Justify.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseJustify(s string) (Justify, error) {
+	i, ok := parseEnumName(justifyNames, s)
+	if !ok {
+		return JustifyStart, fmt.Errorf("%w: %q", ErrInvalidJustify, s)
+	}
+	return Justify(i), nil
+}
+
 // WrapMode defines how text wraps or truncates.
 type WrapMode int
 
@@ -138,8 +297,29 @@ const (
 	WrapChar
 	// WrapTruncate truncates text with ellipsis.
 	WrapTruncate
+	// WrapOverflow breaks text into rows of exactly the available width,
+	// splitting words, and clips rows beyond the available height, without
+	// an ellipsis or overflow marker.
+	WrapOverflow
 )
 
+var wrapModeNames = []string{"none", "word", "char", "truncate", "overflow"}
+
+// String returns the lowercase name of the wrap mode, e.g. "word".
+func (w WrapMode) String() string {
+	return enumName(wrapModeNames, int(w), "WrapMode")
+}
+
+// ParseWrapMode converts a name such as "truncate" into a WrapMode.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseWrapMode(s string) (WrapMode, error) {
+	i, ok := parseEnumName(wrapModeNames, s)
+	if !ok {
+		return WrapNone, fmt.Errorf("%w: %q", ErrInvalidWrapMode, s)
+	}
+	return WrapMode(i), nil
+}
+
 // TextAlign defines horizontal text alignment.
 type TextAlign int
 
@@ -151,3 +331,89 @@ const (
 	// TextAlignRight aligns text to the right.
 	TextAlignRight
 )
+
+var textAlignNames = []string{"left", "center", "right"}
+
+// String returns the lowercase name of the text alignment, e.g. "right".
+func (a TextAlign) String() string {
+	return enumName(textAlignNames, int(a), "TextAlign")
+}
+
+// ParseTextAlign converts a name such as "center" into a TextAlign.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseTextAlign(s string) (TextAlign, error) {
+	i, ok := parseEnumName(textAlignNames, s)
+	if !ok {
+		return TextAlignLeft, fmt.Errorf("%w: %q", ErrInvalidTextAlign, s)
+	}
+	return TextAlign(i), nil
+}
+
+// WhiteSpaceMode defines how text handles spaces and line breaks.
+type WhiteSpaceMode int
+
+const (
+	// WhiteSpaceNormal wraps text according to its WrapMode (default).
+	WhiteSpaceNormal WhiteSpaceMode = iota
+	// WhiteSpacePre preserves spaces, tabs and newlines exactly as written.
+	WhiteSpacePre
+	// WhiteSpaceNowrap renders text on a single line without line breaks.
+	WhiteSpaceNowrap
+)
+
+var whiteSpaceNames = []string{"normal", "pre", "nowrap"}
+
+// String returns the lowercase name of the white space mode, e.g. "pre".
+func (w WhiteSpaceMode) String() string {
+	return enumName(whiteSpaceNames, int(w), "WhiteSpaceMode")
+}
+
+// ParseWhiteSpaceMode converts a name such as "nowrap" into a WhiteSpaceMode.
+// Matching is case-insensitive and ignores surrounding whitespace.
+func ParseWhiteSpaceMode(s string) (WhiteSpaceMode, error) {
+	i, ok := parseEnumName(whiteSpaceNames, s)
+	if !ok {
+		return WhiteSpaceNormal, fmt.Errorf("%w: %q", ErrInvalidWhiteSpace, s)
+	}
+	return WhiteSpaceMode(i), nil
+}
+
+// OverflowMode defines how a box handles children that exceed its dimensions.
+type OverflowMode int
+
+const (
+	// OverflowVisible lets children extend beyond the box (default).
+	OverflowVisible OverflowMode = iota
+	// OverflowHidden clips children to the box dimensions.
+	OverflowHidden
+	// OverflowScroll clips children to the box dimensions, starting at ScrollOffset.
+	OverflowScroll
+)
+
+// CursorPosition is a zero-based row and column relative to the top-left
+// corner of a box. The cursor is only placed when Visible is true, so the
+// zero value means no cursor and {Visible: true} is the top-left cell.
+type CursorPosition struct {
+	Row     int
+	Col     int
+	Visible bool
+}
+
+// enumName returns names[value], or a Go-syntax fallback for unknown values.
+func enumName(names []string, value int, typeName string) string {
+	if value < 0 || value >= len(names) {
+		return fmt.Sprintf("%s(%d)", typeName, value)
+	}
+	return names[value]
+}
+
+// parseEnumName returns the index of s in names, ignoring case and surrounding whitespace.
+func parseEnumName(names []string, s string) (int, bool) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for i, candidate := range names {
+		if candidate == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
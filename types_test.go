@@ -1,6 +1,10 @@
 package runetui
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func TestDirection_Column_IsZero(t *testing.T) {
 	if Column != 0 {
@@ -43,6 +47,39 @@ func TestDimensionPercent_StoresValue(t *testing.T) {
 	}
 }
 
+func TestDimensionPercent_Boundaries_AreAccepted(t *testing.T) {
+	for _, value := range []int{0, 100} {
+		dim := DimensionPercent(value)
+		if got := dim.(interface{ Value() int }).Value(); got != value {
+			t.Errorf("expected %d, got %d", value, got)
+		}
+	}
+}
+
+func TestDimensionPercent_OutOfRange_PanicsWithErrInvalidDimension(t *testing.T) {
+	for _, value := range []int{-1, 101, 150} {
+		t.Run(fmt.Sprint(value), func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, ErrInvalidDimension) {
+					t.Errorf("expected panic with ErrInvalidDimension, got %v", err)
+				}
+			}()
+			DimensionPercent(value)
+		})
+	}
+}
+
+func TestDimensionClamp_MinGreaterThanMax_PanicsWithErrInvalidDimension(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrInvalidDimension) {
+			t.Errorf("expected panic with ErrInvalidDimension, got %v", err)
+		}
+	}()
+	DimensionClamp(DimensionFixed(10), 20, 5)
+}
+
 func TestSpacing_WithValues_CreatesCorrectly(t *testing.T) {
 	spacing := Spacing{Top: 1, Right: 2, Bottom: 3, Left: 4}
 	if spacing.Top != 1 || spacing.Right != 2 || spacing.Bottom != 3 || spacing.Left != 4 {
@@ -71,6 +108,34 @@ func TestSpacingHorizontal_SetsLeftAndRight(t *testing.T) {
 	}
 }
 
+func TestSpacingTopBottom_SetsAsymmetricVertical(t *testing.T) {
+	spacing := SpacingTopBottom(1, 3)
+	if spacing.Top != 1 || spacing.Bottom != 3 || spacing.Left != 0 || spacing.Right != 0 {
+		t.Errorf("SpacingTopBottom(1, 3) should set top=1, bottom=3 and leave sides at 0, got %+v", spacing)
+	}
+}
+
+func TestSpacingLeftRight_SetsAsymmetricHorizontal(t *testing.T) {
+	spacing := SpacingLeftRight(2, 5)
+	if spacing.Left != 2 || spacing.Right != 5 || spacing.Top != 0 || spacing.Bottom != 0 {
+		t.Errorf("SpacingLeftRight(2, 5) should set left=2, right=5 and leave top/bottom at 0, got %+v", spacing)
+	}
+}
+
+func TestSpacingXY_SetsHorizontalAndVertical(t *testing.T) {
+	spacing := SpacingXY(4, 1)
+	if spacing.Left != 4 || spacing.Right != 4 || spacing.Top != 1 || spacing.Bottom != 1 {
+		t.Errorf("SpacingXY(4, 1) should set left/right to 4 and top/bottom to 1, got %+v", spacing)
+	}
+}
+
+func TestSpacingCSSLike_FollowsTopRightBottomLeftOrder(t *testing.T) {
+	spacing := SpacingCSSLike(1, 2, 3, 4)
+	if spacing.Top != 1 || spacing.Right != 2 || spacing.Bottom != 3 || spacing.Left != 4 {
+		t.Errorf("SpacingCSSLike(1, 2, 3, 4) should map to top/right/bottom/left, got %+v", spacing)
+	}
+}
+
 func TestSpacing_ZeroValue_CreatesZeroSpacing(t *testing.T) {
 	spacing := Spacing{}
 	if spacing.Top != 0 || spacing.Right != 0 || spacing.Bottom != 0 || spacing.Left != 0 {
@@ -164,6 +229,12 @@ func TestJustify_JustifySpaceBetween_IsThree(t *testing.T) {
 	}
 }
 
+func TestJustify_JustifySpaceEvenly_IsFive(t *testing.T) {
+	if JustifySpaceEvenly != 5 {
+		t.Errorf("JustifySpaceEvenly should be 5, got %d", JustifySpaceEvenly)
+	}
+}
+
 func TestJustify_JustifySpaceAround_IsFour(t *testing.T) {
 	if JustifySpaceAround != 4 {
 		t.Errorf("JustifySpaceAround should be 4, got %d", JustifySpaceAround)
@@ -211,3 +282,232 @@ func TestTextAlign_TextAlignRight_IsTwo(t *testing.T) {
 		t.Errorf("TextAlignRight should be 2, got %d", TextAlignRight)
 	}
 }
+
+func TestOverflowMode_OverflowVisible_IsZero(t *testing.T) {
+	if OverflowVisible != 0 {
+		t.Errorf("OverflowVisible should be 0, got %d", OverflowVisible)
+	}
+}
+
+func TestOverflowMode_OverflowHidden_IsOne(t *testing.T) {
+	if OverflowHidden != 1 {
+		t.Errorf("OverflowHidden should be 1, got %d", OverflowHidden)
+	}
+}
+
+func TestOverflowMode_OverflowScroll_IsTwo(t *testing.T) {
+	if OverflowScroll != 2 {
+		t.Errorf("OverflowScroll should be 2, got %d", OverflowScroll)
+	}
+}
+
+func TestBorderStyle_String_ReturnsLowercaseName(t *testing.T) {
+	tests := map[BorderStyle]string{
+		BorderNone:    "none",
+		BorderSingle:  "single",
+		BorderDouble:  "double",
+		BorderRounded: "rounded",
+	}
+	for style, want := range tests {
+		if got := style.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(style), got, want)
+		}
+	}
+}
+
+func TestBorderStyle_String_UnknownValue_ReturnsGoSyntax(t *testing.T) {
+	if got := BorderStyle(42).String(); got != "BorderStyle(42)" {
+		t.Errorf("expected BorderStyle(42), got %q", got)
+	}
+}
+
+func TestParseBorderStyle_RoundTripsAllStyles(t *testing.T) {
+	for _, style := range []BorderStyle{BorderNone, BorderSingle, BorderDouble, BorderRounded} {
+		got, err := ParseBorderStyle(style.String())
+		if err != nil {
+			t.Fatalf("ParseBorderStyle(%q) returned error: %v", style.String(), err)
+		}
+		if got != style {
+			t.Errorf("ParseBorderStyle(%q) = %v, want %v", style.String(), got, style)
+		}
+	}
+}
+
+func TestParseBorderStyle_IgnoresCaseAndWhitespace(t *testing.T) {
+	got, err := ParseBorderStyle("  Double ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != BorderDouble {
+		t.Errorf("expected BorderDouble, got %v", got)
+	}
+}
+
+func TestParseBorderStyle_Unknown_ReturnsErrInvalidBorderStyle(t *testing.T) {
+	_, err := ParseBorderStyle("dashed")
+	if !errors.Is(err, ErrInvalidBorderStyle) {
+		t.Errorf("expected ErrInvalidBorderStyle, got %v", err)
+	}
+}
+
+func TestDirection_String_ReturnsLowercaseName(t *testing.T) {
+	if got := Column.String(); got != "column" {
+		t.Errorf("Column.String() = %q, want column", got)
+	}
+	if got := Row.String(); got != "row" {
+		t.Errorf("Row.String() = %q, want row", got)
+	}
+	if got := Direction(5).String(); got != "Direction(5)" {
+		t.Errorf("Direction(5).String() = %q, want Direction(5)", got)
+	}
+}
+
+func TestParseDirection_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, d := range []Direction{Column, Row} {
+		got, err := ParseDirection(d.String())
+		if err != nil || got != d {
+			t.Errorf("ParseDirection(%q) = %v, %v; want %v", d.String(), got, err, d)
+		}
+	}
+	if _, err := ParseDirection("diagonal"); !errors.Is(err, ErrInvalidDirection) {
+		t.Errorf("expected ErrInvalidDirection, got %v", err)
+	}
+}
+
+func TestAlign_String_ReturnsLowercaseName(t *testing.T) {
+	tests := map[Align]string{
+		AlignStart:   "start",
+		AlignCenter:  "center",
+		AlignEnd:     "end",
+		AlignStretch: "stretch",
+	}
+	for align, want := range tests {
+		if got := align.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(align), got, want)
+		}
+	}
+}
+
+func TestParseAlign_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, a := range []Align{AlignStart, AlignCenter, AlignEnd, AlignStretch} {
+		got, err := ParseAlign(a.String())
+		if err != nil || got != a {
+			t.Errorf("ParseAlign(%q) = %v, %v; want %v", a.String(), got, err, a)
+		}
+	}
+	if _, err := ParseAlign("baseline"); !errors.Is(err, ErrInvalidAlign) {
+		t.Errorf("expected ErrInvalidAlign, got %v", err)
+	}
+}
+
+func TestAlign_PrintfVerb_UsesName(t *testing.T) {
+	if got := fmt.Sprintf("%v", AlignCenter); got != "center" {
+		t.Errorf("expected %q, got %q", "center", got)
+	}
+}
+
+func TestJustify_String_ReturnsKebabCaseName(t *testing.T) {
+	tests := map[Justify]string{
+		JustifyStart:        "start",
+		JustifyCenter:       "center",
+		JustifyEnd:          "end",
+		JustifySpaceBetween: "space-between",
+		JustifySpaceAround:  "space-around",
+		JustifySpaceEvenly:  "space-evenly",
+		Justify(9):          "Justify(9)",
+	}
+	for justify, want := range tests {
+		if got := justify.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(justify), got, want)
+		}
+	}
+}
+
+func TestParseJustify_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, j := range []Justify{JustifyStart, JustifyCenter, JustifyEnd, JustifySpaceBetween, JustifySpaceAround, JustifySpaceEvenly} {
+		got, err := ParseJustify(j.String())
+		if err != nil || got != j {
+			t.Errorf("ParseJustify(%q) = %v, %v; want %v", j.String(), got, err, j)
+		}
+	}
+	if _, err := ParseJustify("space_between"); !errors.Is(err, ErrInvalidJustify) {
+		t.Errorf("expected ErrInvalidJustify, got %v", err)
+	}
+}
+
+func TestWrapMode_String_ReturnsLowercaseName(t *testing.T) {
+	tests := map[WrapMode]string{
+		WrapNone:     "none",
+		WrapWord:     "word",
+		WrapChar:     "char",
+		WrapTruncate: "truncate",
+		WrapOverflow: "overflow",
+	}
+	for mode, want := range tests {
+		if got := mode.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(mode), got, want)
+		}
+	}
+}
+
+func TestParseWrapMode_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, w := range []WrapMode{WrapNone, WrapWord, WrapChar, WrapTruncate} {
+		got, err := ParseWrapMode(w.String())
+		if err != nil || got != w {
+			t.Errorf("ParseWrapMode(%q) = %v, %v; want %v", w.String(), got, err, w)
+		}
+	}
+	if _, err := ParseWrapMode("ellipsis"); !errors.Is(err, ErrInvalidWrapMode) {
+		t.Errorf("expected ErrInvalidWrapMode, got %v", err)
+	}
+}
+
+func TestTextAlign_String_ReturnsLowercaseName(t *testing.T) {
+	tests := map[TextAlign]string{
+		TextAlignLeft:   "left",
+		TextAlignCenter: "center",
+		TextAlignRight:  "right",
+		TextAlign(7):    "TextAlign(7)",
+	}
+	for align, want := range tests {
+		if got := align.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(align), got, want)
+		}
+	}
+}
+
+func TestParseTextAlign_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, a := range []TextAlign{TextAlignLeft, TextAlignCenter, TextAlignRight} {
+		got, err := ParseTextAlign(a.String())
+		if err != nil || got != a {
+			t.Errorf("ParseTextAlign(%q) = %v, %v; want %v", a.String(), got, err, a)
+		}
+	}
+	if got, err := ParseTextAlign(" Right "); err != nil || got != TextAlignRight {
+		t.Errorf("expected case-insensitive match, got %v, %v", got, err)
+	}
+	if _, err := ParseTextAlign("justify"); !errors.Is(err, ErrInvalidTextAlign) {
+		t.Errorf("expected ErrInvalidTextAlign, got %v", err)
+	}
+}
+
+func TestParseWhiteSpaceMode_RoundTripsAndRejectsUnknown(t *testing.T) {
+	for _, w := range []WhiteSpaceMode{WhiteSpaceNormal, WhiteSpacePre, WhiteSpaceNowrap} {
+		got, err := ParseWhiteSpaceMode(w.String())
+		if err != nil || got != w {
+			t.Errorf("ParseWhiteSpaceMode(%q) = %v, %v; want %v", w.String(), got, err, w)
+		}
+	}
+	if got := WhiteSpaceMode(9).String(); got != "WhiteSpaceMode(9)" {
+		t.Errorf("unexpected fallback name %q", got)
+	}
+	if _, err := ParseWhiteSpaceMode("pre-wrap"); !errors.Is(err, ErrInvalidWhiteSpace) {
+		t.Errorf("expected ErrInvalidWhiteSpace, got %v", err)
+	}
+}
+
+func TestDimensionFill_ResolvesToZero(t *testing.T) {
+	if got := resolveDimension(DimensionFill(), 80); got != 0 {
+		t.Errorf("expected fill to resolve to 0 before growing, got %d", got)
+	}
+}
@@ -0,0 +1,154 @@
+package runetui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// ErrMissingKey is returned when a component that requires a key has none.
+var ErrMissingKey = errors.New("missing key")
+
+// ErrInvalidProps is returned when component properties are inconsistent.
+var ErrInvalidProps = errors.New("invalid props")
+
+// Validatable is an optional extension of Component.
+// Components that implement it can report configuration mistakes before rendering.
+type Validatable interface {
+	Validate() error
+}
+
+// ValidateTree walks the component tree and collects the errors reported by
+// every component that implements Validatable. It returns nil when the tree is valid.
+func ValidateTree(root Component) []error {
+	if root == nil {
+		return nil
+	}
+
+	var errs []error
+	if v, ok := root.(Validatable); ok {
+		if err := v.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, child := range root.Children() {
+		errs = append(errs, ValidateTree(child)...)
+	}
+	return errs
+}
+
+// WithValidation validates the component tree on every render. Each distinct
+// error is logged once: to the static zone when the app was created with
+// WithSlogHandler, and to os.Stderr otherwise. Rendering continues regardless
+// of the result.
+func WithValidation() AppOption {
+	return func(a *App) {
+		a.validation = true
+	}
+}
+
+// reportValidation logs the validation errors for root that have not been
+// logged before.
+func (a *App) reportValidation(root Component) {
+	for _, err := range ValidateTree(root) {
+		key := err.Error()
+		if a.loggedValidation[key] {
+			continue
+		}
+		if a.loggedValidation == nil {
+			a.loggedValidation = make(map[string]bool)
+		}
+		a.loggedValidation[key] = true
+		a.validationLogger().Warn("invalid component", "error", err)
+	}
+}
+
+// validationLogger returns the render logger when the app has a slog handler,
+// and a logger writing to warningOutput otherwise.
+func (a *App) validationLogger() *slog.Logger {
+	if a.slogHandler != nil {
+		return a.renderLogger()
+	}
+	return slog.New(slog.NewTextHandler(warningOutput, &slog.HandlerOptions{ReplaceAttr: dropTime}))
+}
+
+// Validate checks that sizes, constraints and flex factors are not negative
+// and that minimum constraints do not exceed maximum constraints.
+func (b *box) Validate() error {
+	errs := append(validateBoxSizes(b.props), validateBoxConstraints(b.props)...)
+	if b.props.FlexGrow < 0 || b.props.FlexShrink < 0 {
+		errs = append(errs, fmt.Errorf("%w: flex factors must not be negative", ErrInvalidProps))
+	}
+	if b.props.IsStatic && b.props.Key == "" {
+		errs = append(errs, fmt.Errorf("%w: static box", ErrMissingKey))
+	}
+	return componentError("box", b.props.Key, errs)
+}
+
+// validateBoxSizes reports negative fixed dimensions and negative gap.
+func validateBoxSizes(props BoxProps) []error {
+	var errs []error
+	if fixed, ok := props.Width.(dimensionFixed); ok && fixed.Value() < 0 {
+		errs = append(errs, fmt.Errorf("%w: Width is %d", ErrInvalidDimension, fixed.Value()))
+	}
+	if fixed, ok := props.Height.(dimensionFixed); ok && fixed.Value() < 0 {
+		errs = append(errs, fmt.Errorf("%w: Height is %d", ErrInvalidDimension, fixed.Value()))
+	}
+	if props.Gap < 0 {
+		errs = append(errs, fmt.Errorf("%w: Gap is %d", ErrInvalidProps, props.Gap))
+	}
+	if props.GapX < 0 {
+		errs = append(errs, fmt.Errorf("%w: GapX is %d", ErrInvalidProps, props.GapX))
+	}
+	if props.GapY < 0 {
+		errs = append(errs, fmt.Errorf("%w: GapY is %d", ErrInvalidProps, props.GapY))
+	}
+	return errs
+}
+
+// validateBoxConstraints reports negative or contradictory min/max constraints.
+func validateBoxConstraints(props BoxProps) []error {
+	var errs []error
+	if props.MinWidth < 0 || props.MinHeight < 0 || props.MaxWidth < 0 || props.MaxHeight < 0 {
+		errs = append(errs, fmt.Errorf("%w: min/max constraints must not be negative", ErrInvalidProps))
+	}
+	if props.MaxWidth > 0 && props.MinWidth > props.MaxWidth {
+		errs = append(errs, fmt.Errorf("%w: MinWidth %d exceeds MaxWidth %d", ErrInvalidProps, props.MinWidth, props.MaxWidth))
+	}
+	if props.MaxHeight > 0 && props.MinHeight > props.MaxHeight {
+		errs = append(errs, fmt.Errorf("%w: MinHeight %d exceeds MaxHeight %d", ErrInvalidProps, props.MinHeight, props.MaxHeight))
+	}
+	return errs
+}
+
+// Validate checks that the wrap mode and alignment are known values.
+func (t *text) Validate() error {
+	var errs []error
+	if t.props.Wrap < WrapNone || t.props.Wrap > WrapOverflow {
+		errs = append(errs, fmt.Errorf("%w: unknown wrap mode %d", ErrInvalidProps, int(t.props.Wrap)))
+	}
+	if t.props.Align < TextAlignLeft || t.props.Align > TextAlignRight {
+		errs = append(errs, fmt.Errorf("%w: unknown align %d", ErrInvalidProps, int(t.props.Align)))
+	}
+	return componentError("text", t.props.Key, errs)
+}
+
+// Validate checks that the static zone has a key and an items function.
+func (s *static) Validate() error {
+	var errs []error
+	if s.props.Key == "" {
+		errs = append(errs, fmt.Errorf("%w: static zones are tracked by key", ErrMissingKey))
+	}
+	if s.itemsFunc == nil {
+		errs = append(errs, fmt.Errorf("%w: items function is nil", ErrInvalidProps))
+	}
+	return componentError("static", s.props.Key, errs)
+}
+
+// componentError joins errs and prefixes them with the component kind and key.
+func componentError(kind, key string, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s %q: %w", kind, key, errors.Join(errs...))
+}
@@ -0,0 +1,138 @@
+package runetui
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateTree_ValidTree_ReturnsNil(t *testing.T) {
+	root := VStack(
+		Text("Title", TextProps{Bold: true}),
+		Box(BoxProps{Width: DimensionFixed(10), MinWidth: 2, MaxWidth: 20}, Text("body")),
+		Static(StaticProps{Key: "logs"}, func() []Component { return nil }),
+	)
+
+	if errs := ValidateTree(root); errs != nil {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateTree_NilRoot_ReturnsNil(t *testing.T) {
+	if errs := ValidateTree(nil); errs != nil {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateTree_CollectsErrorsFromNestedComponents(t *testing.T) {
+	root := VStack(
+		Box(BoxProps{Key: "sidebar", Width: DimensionFixed(-5)}),
+		HStack(Static(StaticProps{}, func() []Component { return nil })),
+	)
+
+	errs := ValidateTree(root)
+
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(errs), errs)
+	}
+	if !errors.Is(errs[0], ErrInvalidDimension) || !strings.Contains(errs[0].Error(), `box "sidebar"`) {
+		t.Errorf("expected invalid dimension on sidebar, got %v", errs[0])
+	}
+	if !errors.Is(errs[1], ErrMissingKey) {
+		t.Errorf("expected missing key on static, got %v", errs[1])
+	}
+}
+
+func TestBox_Validate_MinExceedsMax_ReturnsErrInvalidProps(t *testing.T) {
+	err := Box(BoxProps{MinWidth: 30, MaxWidth: 10}).(Validatable).Validate()
+
+	if !errors.Is(err, ErrInvalidProps) || !strings.Contains(err.Error(), "MinWidth 30 exceeds MaxWidth 10") {
+		t.Errorf("expected min/max error, got %v", err)
+	}
+}
+
+func TestBox_Validate_NegativeGapAndFlex_ReturnsAllErrors(t *testing.T) {
+	err := Box(BoxProps{Gap: -1, FlexGrow: -1}).(Validatable).Validate()
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "Gap is -1") || !strings.Contains(err.Error(), "flex factors") {
+		t.Errorf("expected gap and flex errors, got %v", err)
+	}
+}
+
+func TestBox_Validate_NegativeAxisGaps_ReturnsErrors(t *testing.T) {
+	err := Box(BoxProps{GapX: -1, GapY: -2}).(Validatable).Validate()
+
+	if !errors.Is(err, ErrInvalidProps) || !strings.Contains(err.Error(), "GapX is -1") || !strings.Contains(err.Error(), "GapY is -2") {
+		t.Errorf("expected GapX and GapY errors, got %v", err)
+	}
+}
+
+func TestBox_Validate_StaticWithoutKey_ReturnsErrMissingKey(t *testing.T) {
+	err := Box(BoxProps{IsStatic: true}).(Validatable).Validate()
+
+	if !errors.Is(err, ErrMissingKey) {
+		t.Errorf("expected ErrMissingKey, got %v", err)
+	}
+}
+
+func TestText_Validate_UnknownWrapMode_ReturnsErrInvalidProps(t *testing.T) {
+	err := Text("hi", TextProps{Wrap: WrapMode(9)}).(Validatable).Validate()
+
+	if !errors.Is(err, ErrInvalidProps) {
+		t.Errorf("expected ErrInvalidProps, got %v", err)
+	}
+}
+
+func TestStatic_Validate_NilItemsFunc_ReturnsErrInvalidProps(t *testing.T) {
+	err := Static(StaticProps{Key: "logs"}, nil).(Validatable).Validate()
+
+	if !errors.Is(err, ErrInvalidProps) {
+		t.Errorf("expected ErrInvalidProps, got %v", err)
+	}
+}
+
+func TestWithValidation_LogsErrorsToStaticZoneOnce(t *testing.T) {
+	app := New(func() Component {
+		return VStack(Box(BoxProps{Key: "bad", Gap: -2}, Text("content")))
+	}, WithValidation(), WithSlogHandler())
+	m := app.createModel()
+
+	m.View()
+	output := m.View()
+
+	logged := app.staticManager.RenderStatic()
+	if got := strings.Count(logged, `box \"bad\": invalid props: Gap is -2`); got != 1 {
+		t.Errorf("expected the validation error to be logged once, got %d in %q", got, logged)
+	}
+	AssertContainsText(t, output, "invalid component")
+	AssertContainsText(t, output, "content")
+}
+
+func TestWithValidation_WithoutSlogHandler_LogsToStderr(t *testing.T) {
+	var logged bytes.Buffer
+	previous := warningOutput
+	warningOutput = &logged
+	defer func() { warningOutput = previous }()
+	app := New(func() Component { return Box(BoxProps{Key: "bad", Gap: -2}) }, WithValidation())
+	m := app.createModel()
+
+	m.View()
+	m.View()
+
+	if got := strings.Count(logged.String(), `msg="invalid component"`); got != 1 {
+		t.Errorf("expected the validation error to be logged once, got %d times in %q", got, logged.String())
+	}
+	AssertContainsText(t, logged.String(), `box \"bad\": invalid props: Gap is -2`)
+}
+
+func TestWithoutValidation_DoesNotValidate(t *testing.T) {
+	app := New(func() Component { return Box(BoxProps{Gap: -2}) })
+
+	if app.validation {
+		t.Error("expected validation to be disabled by default")
+	}
+}
@@ -0,0 +1,122 @@
+package runetui
+
+import (
+	"strings"
+)
+
+// viewportContentHeight is the height content is measured against, large
+// enough that no content is cut short before the viewport clips it.
+const viewportContentHeight = 1 << 16
+
+// ViewportProps defines properties for the Viewport component.
+// ScrollOffset is the first visible content line and is clamped so the
+// viewport never scrolls past the end of the content.
+type ViewportProps struct {
+	Width          int
+	Height         int
+	ScrollOffset   int
+	ShowScrollbar  bool
+	ScrollbarColor string
+	Key            string
+}
+
+func (ViewportProps) isProps() {}
+
+// viewport is the private implementation of the Viewport component.
+type viewport struct {
+	props   ViewportProps
+	content Component
+}
+
+// Viewport creates a fixed-size window onto content that may be taller than
+// the screen. The content is rendered at its full height and the lines from
+// ScrollOffset onwards are shown. With ShowScrollbar, the rightmost column
+// shows a ▓ thumb on a ░ track. A nil content is treated as empty.
+//
+// Example:
+//
+//	Viewport(ViewportProps{Width: 60, Height: 10, ScrollOffset: state.scroll, ShowScrollbar: true},
+//	    VStack(logLines...))
+func Viewport(props ViewportProps, content Component) Component {
+	if content == nil {
+		content = Box(BoxProps{})
+	}
+	return &viewport{props: props, content: content}
+}
+
+// contentWidth returns the width available to the content.
+func (v *viewport) contentWidth() int {
+	if v.props.ShowScrollbar {
+		return max(0, v.props.Width-1)
+	}
+	return v.props.Width
+}
+
+// Render generates Height lines of the content starting at ScrollOffset,
+// each padded or clipped to the width, followed by the scrollbar if shown.
+func (v *viewport) Render(layout Layout) string {
+	width, height := v.contentWidth(), v.props.Height
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	size := measureChild(v.content, Column, width, viewportContentHeight)
+	rendered := v.content.Render(Layout{X: layout.X, Y: layout.Y, Width: width, Height: size.Height})
+	lines := strings.Split(rendered, "\n")
+
+	maxOffset := max(0, len(lines)-height)
+	offset := max(0, min(v.props.ScrollOffset, maxOffset))
+	visible := padBlock(clipLines(rendered, width, height, offset), width, height)
+
+	if !v.props.ShowScrollbar {
+		return visible
+	}
+	scrollbar := v.scrollbar(len(lines), offset)
+	rows := strings.Split(visible, "\n")
+	for i := range rows {
+		rows[i] += scrollbar[i]
+	}
+	return strings.Join(rows, "\n")
+}
+
+// scrollbar returns one cell per visible line: ▓ for the thumb, whose size
+// and position reflect the visible share of total lines, and ░ elsewhere.
+func (v *viewport) scrollbar(total, offset int) []string {
+	height := v.props.Height
+	thumb := height
+	start := 0
+	if total > height {
+		thumb = max(1, (height*height+total/2)/total)
+		start = offset * (height - thumb) / (total - height)
+	}
+
+	style := TextProps{Color: v.props.ScrollbarColor}
+	cells := make([]string, height)
+	for i := range cells {
+		cell := "░"
+		if i >= start && i < start+thumb {
+			cell = "▓"
+		}
+		cells[i] = Text(cell, style).Render(Layout{})
+	}
+	return cells
+}
+
+// Children returns the content.
+func (v *viewport) Children() []Component {
+	return []Component{v.content}
+}
+
+// Key returns the unique identifier for this component.
+func (v *viewport) Key() string {
+	return v.props.Key
+}
+
+func (v *viewport) label() string {
+	return "viewport"
+}
+
+// Measure returns exactly Width and Height.
+func (v *viewport) Measure(availableWidth, availableHeight int) Size {
+	return Size{Width: v.props.Width, Height: v.props.Height}
+}
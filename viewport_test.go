@@ -0,0 +1,109 @@
+package runetui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func numberedLines(n int) Component {
+	lines := make([]Component, n)
+	for i := range lines {
+		lines[i] = Text(fmt.Sprintf("line %d", i+1))
+	}
+	return VStack(lines...)
+}
+
+func TestViewport_Render_ClipsToScrollWindow(t *testing.T) {
+	c := Viewport(ViewportProps{Width: 8, Height: 3, ScrollOffset: 2}, numberedLines(10))
+
+	expected := "line 3  \nline 4  \nline 5  "
+	if output := c.Render(Layout{Width: 8, Height: 3}); output != expected {
+		t.Errorf("expected %q, got %q", expected, output)
+	}
+}
+
+func TestViewport_Render_ClampsScrollOffset(t *testing.T) {
+	tests := map[int]string{
+		-5: "line 1\nline 2",
+		99: "line 4\nline 5",
+	}
+
+	for offset, expected := range tests {
+		c := Viewport(ViewportProps{Width: 6, Height: 2, ScrollOffset: offset}, numberedLines(5))
+		if output := c.Render(Layout{}); output != expected {
+			t.Errorf("offset %d: expected %q, got %q", offset, expected, output)
+		}
+	}
+}
+
+func TestViewport_Render_PadsShortContent(t *testing.T) {
+	c := Viewport(ViewportProps{Width: 4, Height: 3}, Text("hi"))
+
+	if output := c.Render(Layout{}); output != "hi  \n    \n    " {
+		t.Errorf("expected content padded to the viewport, got %q", output)
+	}
+}
+
+func TestViewport_NilContent_RendersEmptyWindow(t *testing.T) {
+	c := Viewport(ViewportProps{Width: 3, Height: 2, ShowScrollbar: true}, nil)
+
+	tree := NewLayoutEngine(80, 24).CalculateLayout(c)
+
+	if output := StripANSI(renderTree(tree)); output != "  ▓\n  ▓" {
+		t.Errorf("expected a blank window, got %q", output)
+	}
+}
+
+func TestViewport_Render_Scrollbar(t *testing.T) {
+	tests := []struct {
+		offset   int
+		expected string
+	}{
+		{0, "▓▓░░"},
+		{3, "░▓▓░"},
+		{6, "░░▓▓"},
+	}
+
+	for _, tt := range tests {
+		c := Viewport(ViewportProps{Width: 7, Height: 4, ScrollOffset: tt.offset, ShowScrollbar: true}, numberedLines(10))
+		var bar strings.Builder
+		for _, line := range strings.Split(StripANSI(c.Render(Layout{})), "\n") {
+			runes := []rune(line)
+			if len(runes) != 7 {
+				t.Fatalf("expected 7 columns, got %q", line)
+			}
+			bar.WriteRune(runes[6])
+		}
+		if bar.String() != tt.expected {
+			t.Errorf("offset %d: expected scrollbar %q, got %q", tt.offset, tt.expected, bar.String())
+		}
+	}
+}
+
+func TestViewport_Render_ScrollbarColor(t *testing.T) {
+	c := Viewport(ViewportProps{Width: 3, Height: 1, ShowScrollbar: true, ScrollbarColor: "#FF0000"}, Text("ab"))
+
+	if output := c.Render(Layout{}); !strings.Contains(output, "38;2;255;0;0") || StripANSI(output) != "ab▓" {
+		t.Errorf("expected a colored full thumb, got %q", output)
+	}
+}
+
+func TestViewport_Measure_ReturnsPropsSize(t *testing.T) {
+	c := Viewport(ViewportProps{Width: 30, Height: 5}, numberedLines(100))
+
+	if size := c.Measure(80, 24); size != (Size{Width: 30, Height: 5}) {
+		t.Errorf("expected 30x5, got %+v", size)
+	}
+}
+
+func TestViewport_InLayout_DoesNotOverflow(t *testing.T) {
+	root := VStack(Text("header"), Viewport(ViewportProps{Width: 10, Height: 2, Key: "logs"}, numberedLines(50)))
+	tree := NewLayoutEngine(20, 10).CalculateLayout(root)
+
+	output := root.Render(tree.Layout)
+
+	if VisualHeight(output) != 3 {
+		t.Errorf("expected header plus two viewport lines, got %q", output)
+	}
+}